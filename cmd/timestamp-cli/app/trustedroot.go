@@ -0,0 +1,109 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
+	"github.com/sigstore/timestamp-authority/cmd/timestamp-cli/app/format"
+	"github.com/sigstore/timestamp-authority/pkg/client"
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func addTrustedRootFlags(cmd *cobra.Command) {
+	cmd.Flags().String("out", "", "path to a file to write the trusted root to. Written to stdout if not set")
+}
+
+type trustedRootCmdOutput struct {
+	TrustedRoot json.RawMessage `json:",omitempty"`
+	Location    string          `json:",omitempty"`
+}
+
+func (t *trustedRootCmdOutput) String() string {
+	if t.Location != "" {
+		return fmt.Sprintf("Wrote trusted root to %v\n", t.Location)
+	}
+	return string(t.TrustedRoot) + "\n"
+}
+
+var trustedRootCmd = &cobra.Command{
+	Use:   "trusted-root",
+	Short: "Export the timestamp authority as Sigstore TrustedRoot entries",
+	Long: `Fetches the timestampAuthorities entries of a Sigstore trusted_root.json describing the timestamp
+authority, one for each certificate chain it has used.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			log.CliLogger.Fatal("Error initializing cmd line args: ", err)
+		}
+		return nil
+	},
+	Run: format.WrapCmd(func(_ []string) (interface{}, error) {
+		return runTrustedRoot()
+	}),
+}
+
+func runTrustedRoot() (interface{}, error) {
+	tsClient, err := client.GetTimestampClient(viper.GetString("timestamp_server"), client.WithUserAgent(UserAgent()))
+	if err != nil {
+		return nil, err
+	}
+
+	params := ts.NewGetTimestampTrustedRootParams()
+	params.SetTimeout(viper.GetDuration("timeout"))
+	resp, err := tsClient.Timestamp.GetTimestampTrustedRoot(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate that the response is a trusted root
+	payload, err := json.Marshal(resp.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var trustedRoot prototrustroot.TrustedRoot
+	if err := protojson.Unmarshal(payload, &trustedRoot); err != nil {
+		return nil, fmt.Errorf("parsing trusted root: %w", err)
+	}
+	if len(trustedRoot.GetTimestampAuthorities()) == 0 {
+		return nil, fmt.Errorf("trusted root has no timestamp authorities")
+	}
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(&trustedRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	outStr := viper.GetString("out")
+	if outStr == "" {
+		return &trustedRootCmdOutput{TrustedRoot: out}, nil
+	}
+	if err := os.WriteFile(outStr, out, 0600); err != nil {
+		return nil, err
+	}
+	return &trustedRootCmdOutput{Location: outStr}, nil
+}
+
+func init() {
+	initializePFlagMap()
+	addTrustedRootFlags(trustedRootCmd)
+	rootCmd.AddCommand(trustedRootCmd)
+}
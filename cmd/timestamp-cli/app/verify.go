@@ -51,6 +51,7 @@ func addVerifyFlags(cmd *cobra.Command) {
 	cmd.Flags().String("nonce", "", "optional nonce passed with the request")
 	cmd.Flags().Var(NewFlagValue(oidFlag, ""), "oid", "optional TSA policy OID passed with the request")
 	cmd.Flags().String("common-name", "", "expected leaf certificate subject common name")
+	cmd.Flags().Bool("require-certificate-policy", false, "require the TSA policy OID of the timestamp to be listed in the certificate policies of the leaf certificate")
 	cmd.Flags().Var(NewFlagValue(fileFlag, ""), "certificate", "path to file with PEM-encoded leaf certificate")
 	cmd.Flags().Var(NewFlagValue(fileFlag, ""), "intermediate-certificates", "path to file with PEM-encoded intermediate certificates. Must be called with the root-certificate flag.")
 	cmd.Flags().Var(NewFlagValue(fileFlag, ""), "root-certificates", "path to file with a PEM-encoded root certificates. Optionally can be called with the intermediate-certificates flag.")
@@ -131,6 +132,8 @@ func newVerifyOpts() (verification.VerifyOpts, error) {
 	commonNameFlagVal := viper.GetString("common-name")
 	opts.CommonName = commonNameFlagVal
 
+	opts.RequireCertificatePolicy = viper.GetBool("require-certificate-policy")
+
 	return opts, nil
 }
 
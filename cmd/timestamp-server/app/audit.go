@@ -0,0 +1,88 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/spf13/cobra"
+
+	"github.com/sigstore/timestamp-authority/pkg/audit"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the audit log",
+	Long:  `Commands to inspect the audit log of timestamp requests`,
+}
+
+var auditVerifyCmd = &cobra.Command{
+	Use:   "verify <file>",
+	Short: "Verify the audit log",
+	Long: `Verifies the hash chain of an audit log and the timestamp of every checkpoint,
+detecting records that were modified, reordered or removed, including records removed
+from the end of the log up to the latest checkpoint. Records written after the latest
+checkpoint are reported as unanchored.`,
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := audit.VerifyOpts{}
+		if path, _ := cmd.Flags().GetString("certificate-chain"); path != "" {
+			data, err := os.ReadFile(filepath.Clean(path))
+			if err != nil {
+				return err
+			}
+			opts.CertChain, err = cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("loading %s: %w", path, err)
+			}
+			if len(opts.CertChain) < 2 {
+				return fmt.Errorf("%s must contain the leaf certificate and the root", path)
+			}
+		}
+
+		res, err := audit.Verify(args[0], opts)
+		if err != nil {
+			return fmt.Errorf("audit log is invalid: %w", err)
+		}
+
+		w := cmd.OutOrStdout()
+		fmt.Fprintf(w, "audit log is valid\n")
+		fmt.Fprintf(w, "records:          %d\n", res.Records)
+		fmt.Fprintf(w, "checkpoints:      %d\n", res.Checkpoints)
+		if res.Checkpoints > 0 {
+			fmt.Fprintf(w, "last checkpoint:  %s\n", res.LastCheckpoint.UTC().Format(time.RFC3339))
+		}
+		fmt.Fprintf(w, "unanchored:       %d\n", res.Unanchored)
+		fmt.Fprintf(w, "head:             %s\n", res.Head)
+		if res.HeadMissing {
+			fmt.Fprintf(w, "warning:          %s is missing, records removed from the end of the log cannot be detected\n", audit.HeadPath(args[0]))
+		}
+		return nil
+	},
+}
+
+func init() {
+	auditVerifyCmd.Flags().String("certificate-chain", "", "Path to the PEM-encoded certificate chain verifying checkpoint timestamps. Only the message imprint of checkpoints is checked when empty")
+	auditCmd.AddCommand(auditVerifyCmd)
+	rootCmd.AddCommand(auditCmd)
+}
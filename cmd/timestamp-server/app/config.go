@@ -0,0 +1,190 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sigstore/timestamp-authority/pkg/config"
+)
+
+// configFromFlags builds the server configuration from the individual
+// command-line flags.
+func configFromFlags() *config.Config {
+	cfg := config.Default()
+
+	cfg.Signer = config.SignerConfig{
+		Type: viper.GetString("timestamp-signer"),
+		Hash: viper.GetString("timestamp-signer-hash"),
+		KMS: config.KMSSignerConfig{
+			KeyResource: viper.GetString("kms-key-resource"),
+		},
+		Tink: config.TinkSignerConfig{
+			KeyResource:  viper.GetString("tink-key-resource"),
+			KeysetPath:   viper.GetString("tink-keyset-path"),
+			HCVaultToken: viper.GetString("tink-hcvault-token"),
+		},
+		File: config.FileSignerConfig{
+			KeyPath:  viper.GetString("file-signer-key-path"),
+			Password: viper.GetString("file-signer-passwd"),
+		},
+		VerifyAfterSign:     viper.GetBool("verify-after-sign"),
+		TripOnVerifyFailure: viper.GetBool("trip-on-verify-failure"),
+	}
+	cfg.Chain = config.ChainConfig{
+		Path:                viper.GetString("certificate-chain-path"),
+		ExpiryWarning:       viper.GetDuration("certificate-expiry-warning"),
+		ExpiryFailReadiness: viper.GetBool("certificate-expiry-fail-readiness"),
+	}
+	for _, path := range viper.GetStringSlice("certificate-chain-history") {
+		cfg.Chain.History = append(cfg.Chain.History, config.HistoricalChainConfig{Path: path})
+	}
+	cfg.NTP = config.NTPConfig{
+		Disabled:      viper.GetBool("disable-ntp-monitoring"),
+		ConfigPath:    viper.GetString("ntp-monitoring"),
+		CorrectTime:   viper.GetBool("ntp-correct-time"),
+		MaxCorrection: viper.GetDuration("ntp-max-correction"),
+	}
+	cfg.Listeners = config.ListenersConfig{
+		Host:         viper.GetString("host"),
+		Port:         viper.GetInt("port"),
+		Schemes:      viper.GetStringSlice("scheme"),
+		HTTPPingOnly: viper.GetBool("http-ping-only"),
+		ReadTimeout:  viper.GetDuration("read-timeout"),
+		WriteTimeout: viper.GetDuration("write-timeout"),
+		Socket: config.SocketConfig{
+			Mode:  viper.GetString("socket-mode"),
+			Owner: viper.GetString("socket-owner"),
+			Group: viper.GetString("socket-group"),
+		},
+	}
+	// the default of --socket-path is a placeholder of the generated server
+	if viper.IsSet("socket-path") {
+		cfg.Listeners.Socket.Path = viper.GetString("socket-path")
+	}
+	cfg.TLS = config.TLSConfig{
+		Host:          viper.GetString("tls-host"),
+		Port:          viper.GetInt("tls-port"),
+		Certificate:   viper.GetString("tls-certificate"),
+		Key:           viper.GetString("tls-key"),
+		CACertificate: viper.GetString("tls-ca"),
+		ClientAuth:    viper.GetString("tls-client-auth"),
+		MinVersion:    viper.GetString("tls-min-version"),
+	}
+	cfg.Metrics.Pprof.Enabled = viper.GetBool("enable-pprof")
+	cfg.Policies.RequireCertificatePolicy = viper.GetBool("require-certificate-policy")
+	cfg.TrustedRoot.URI = viper.GetString("trusted-root-uri")
+	cfg.Audit = config.AuditConfig{
+		Path:               viper.GetString("audit-log-path"),
+		CheckpointInterval: viper.GetDuration("audit-checkpoint-interval"),
+		FailClosed:         viper.GetBool("audit-fail-closed"),
+	}
+	cfg.Tracing = config.TracingConfig{
+		Exporter:    viper.GetString("tracing-exporter"),
+		Endpoint:    viper.GetString("tracing-endpoint"),
+		Path:        viper.GetString("tracing-file"),
+		SampleRatio: viper.GetFloat64("tracing-sample-ratio"),
+	}
+	cfg.Admin = config.AdminConfig{
+		Address:   viper.GetString("admin-address"),
+		TokenFile: viper.GetString("admin-token-file"),
+		TLS: config.AdminTLSConfig{
+			Certificate: viper.GetString("admin-tls-certificate"),
+			Key:         viper.GetString("admin-tls-key"),
+		},
+	}
+	cfg.Shutdown = config.ShutdownConfig{
+		ReadinessDelay: viper.GetDuration("shutdown-readiness-delay"),
+		GracePeriod:    viper.GetDuration("shutdown-grace-period"),
+	}
+	cfg.Artifacts = config.ArtifactsConfig{
+		Enabled: viper.GetBool("enable-artifact-hashing"),
+		MaxSize: viper.GetInt64("artifact-max-size"),
+	}
+	cfg.GRPC = config.GRPCConfig{
+		Address: viper.GetString("grpc-address"),
+		TLS:     viper.GetBool("grpc-tls"),
+	}
+	cfg.Linking.Path = viper.GetString("linking-path")
+	cfg.Roughtime = config.RoughtimeConfig{
+		Address:            viper.GetString("roughtime-address"),
+		KeyPath:            viper.GetString("roughtime-key-path"),
+		Radius:             viper.GetDuration("roughtime-radius"),
+		DelegationValidity: viper.GetDuration("roughtime-delegation-validity"),
+	}
+
+	return cfg
+}
+
+// loadConfig loads the server configuration from the file given by
+// --server-config, or from the individual flags if no file is given, and
+// validates it. The returned error joins every problem found.
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		cfg := configFromFlags()
+		return cfg, cfg.Validate()
+	}
+	cfg, err := config.Load(path)
+	if cfg == nil {
+		return nil, err
+	}
+	return cfg, errors.Join(err, cfg.Validate())
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the server configuration",
+	Long:  `Commands to inspect the timestamp server configuration`,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate [file]",
+	Short: "Validate the server configuration",
+	Long: `Validates a versioned server configuration file, or the configuration given by
+--server-config or the individual flags when no file is provided. Every error found is reported.`,
+	Args:          cobra.MaximumNArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return err
+		}
+		path := viper.GetString("server-config")
+		if len(args) == 1 {
+			path = args[0]
+		}
+
+		_, err := loadConfig(path)
+		if err != nil {
+			errs := config.Errors(err)
+			for _, e := range errs {
+				fmt.Fprintln(cmd.ErrOrStderr(), e)
+			}
+			return fmt.Errorf("configuration is invalid: %d error(s) found", len(errs))
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "configuration is valid")
+		return nil
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
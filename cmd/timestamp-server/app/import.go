@@ -0,0 +1,139 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sigstore/timestamp-authority/pkg/config"
+)
+
+var importOpenSSLConfigCmd = &cobra.Command{
+	Use:   "import-openssl-config <openssl.cnf>",
+	Short: "Translate an OpenSSL TSA configuration into a server configuration",
+	Long: `Translates the TSA section of an OpenSSL configuration file, as used by openssl ts -reply, into a
+versioned server configuration, written to standard output or --output. The section named by default_tsa
+in the [ tsa ] section is used, unless --section is given.
+
+Every setting that cannot be translated is reported on standard error. OpenSSL splits the certificate
+chain into signer_cert and certs, so it is only written when --chain-output is given, ordered from the
+signer certificate to the root, and referenced as chain.path.`,
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		section, _ := cmd.Flags().GetString("section")
+		imp, err := config.ImportOpenSSL(data, section)
+		if err != nil {
+			return fmt.Errorf("importing %s: %w", args[0], err)
+		}
+
+		chainOutput, _ := cmd.Flags().GetString("chain-output")
+		switch {
+		case chainOutput != "":
+			chain, err := orderedChain(imp.CertPaths)
+			if err != nil {
+				return err
+			}
+			pemChain, err := cryptoutils.MarshalCertificatesToPEM(chain)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(chainOutput, pemChain, 0o644); err != nil { //nolint:gosec
+				return err
+			}
+			imp.Config.Chain.Path = chainOutput
+		case len(imp.CertPaths) > 0:
+			fmt.Fprintf(cmd.ErrOrStderr(), "chain.path: combine %s into a single chain, or use --chain-output\n", strings.Join(imp.CertPaths, ", "))
+		}
+		for _, u := range imp.Unmapped {
+			fmt.Fprintf(cmd.ErrOrStderr(), "not translated: %s\n", u)
+		}
+
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(imp.Config); err != nil {
+			return err
+		}
+		if output, _ := cmd.Flags().GetString("output"); output != "" {
+			return os.WriteFile(output, buf.Bytes(), 0o600)
+		}
+		_, err = cmd.OutOrStdout().Write(buf.Bytes())
+		return err
+	},
+}
+
+// orderedChain reads the certificates in paths and orders them from the
+// signer certificate, the first one, to the root by following their issuers.
+func orderedChain(paths []string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, path := range paths {
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := cryptoutils.UnmarshalCertificatesFromPEM(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		certs = append(certs, parsed...)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no signer_cert or certs to build the certificate chain from")
+	}
+
+	chain := []*x509.Certificate{certs[0]}
+	remaining := certs[1:]
+	for {
+		last := chain[len(chain)-1]
+		if bytes.Equal(last.RawIssuer, last.RawSubject) && last.CheckSignatureFrom(last) == nil {
+			return chain, nil
+		}
+		next := -1
+		for i, c := range remaining {
+			if last.CheckSignatureFrom(c) == nil {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("issuer of %q not found in signer_cert or certs", last.Subject)
+		}
+		chain = append(chain, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+}
+
+func init() {
+	importOpenSSLConfigCmd.Flags().String("section", "", "TSA section of the OpenSSL configuration. Defaults to default_tsa in the [ tsa ] section")
+	importOpenSSLConfigCmd.Flags().String("output", "", "File the server configuration is written to. Defaults to standard output")
+	importOpenSSLConfigCmd.Flags().String("chain-output", "", "File the certificate chain is written to, and referenced as chain.path")
+	rootCmd.AddCommand(importOpenSSLConfigCmd)
+}
@@ -0,0 +1,61 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"crypto/x509"
+	"strings"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+func TestOrderedChain(t *testing.T) {
+	dir := t.TempDir()
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, _, _ := testutils.GenerateLeafCert(subCert, subKey)
+
+	pemFile := func(name string, certs ...*x509.Certificate) string {
+		data, err := cryptoutils.MarshalCertificatesToPEM(certs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return writeTestFile(t, dir, name, data)
+	}
+	signer := pemFile("signer.pem", leafCert)
+	// certs lists the root before its subordinate
+	shuffled := pemFile("shuffled.pem", rootCert, subCert)
+	rootOnly := pemFile("root.pem", rootCert)
+
+	chain, err := orderedChain([]string{signer, shuffled})
+	if err != nil {
+		t.Fatalf("unexpected error ordering chain: %v", err)
+	}
+	if len(chain) != 3 || !chain[0].Equal(leafCert) || !chain[1].Equal(subCert) || !chain[2].Equal(rootCert) {
+		t.Fatalf("expected the chain to be ordered from the signer to the root, got %d certificates", len(chain))
+	}
+
+	// the subordinate is missing, so the signer's issuer cannot be found
+	if _, err := orderedChain([]string{signer, rootOnly}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected missing issuer error, got %v", err)
+	}
+	if _, err := orderedChain(nil); err == nil {
+		t.Fatal("expected error without certificates")
+	}
+}
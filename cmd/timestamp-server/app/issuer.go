@@ -0,0 +1,190 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/config"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+)
+
+// newSigner creates the timestamp signer from a validated configuration.
+func newSigner(ctx context.Context, cfg *config.Config) (crypto.Signer, crypto.Hash, error) {
+	tsaSignerHash, err := signer.HashToAlg(cfg.Signer.Hash)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error getting hash")
+	}
+	tsaSigner, err := signer.NewCryptoSigner(ctx, tsaSignerHash,
+		cfg.Signer.Type,
+		cfg.Signer.KMS.KeyResource,
+		cfg.Signer.Tink.KeyResource, cfg.Signer.Tink.KeysetPath,
+		cfg.Signer.Tink.HCVaultToken,
+		cfg.Signer.File.KeyPath, cfg.Signer.File.Password)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "getting new tsa signer")
+	}
+	return tsaSigner, tsaSignerHash, nil
+}
+
+// loadCertChain loads the certificate chain for the signer without verifying
+// it. An in-memory chain is generated for the memory signer.
+func loadCertChain(cfg *config.Config, tsaSigner crypto.Signer) ([]*x509.Certificate, error) {
+	// KMS, Tink and File signers require a provided certificate chain
+	if cfg.Signer.Type != signer.MemoryScheme {
+		data, err := os.ReadFile(filepath.Clean(cfg.Chain.Path))
+		if err != nil {
+			return nil, err
+		}
+		return cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(data))
+	}
+
+	// Generate an in-memory TSA certificate chain
+	certChain, err := signer.NewTimestampingCertWithChain(tsaSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating timestamping cert chain")
+	}
+	return certChain, nil
+}
+
+// loadHistoricalChains loads the certificate chains previously used by the
+// server, described for the trusted root.
+func loadHistoricalChains(cfg *config.Config) ([]trustedroot.Chain, error) {
+	chains := make([]trustedroot.Chain, 0, len(cfg.Chain.History))
+	for _, h := range cfg.Chain.History {
+		data, err := os.ReadFile(filepath.Clean(h.Path))
+		if err != nil {
+			return nil, err
+		}
+		certs, err := cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading %s", h.Path)
+		}
+		if len(certs) == 0 {
+			return nil, errors.Errorf("no certificates found in %s", h.Path)
+		}
+		end := h.ValidUntil
+		if end.IsZero() {
+			end = trustedroot.NotAfter(certs)
+		}
+		chains = append(chains, trustedroot.Chain{Certificates: certs, Start: h.ValidFrom, End: end})
+	}
+	return chains, nil
+}
+
+// newIssuer creates the timestamp issuer from a validated configuration. The
+// generation time is corrected with clockOffset when configured to, and
+// timestamps are linked to links when it is not nil.
+func newIssuer(ctx context.Context, cfg *config.Config, clockOffset issuer.ClockOffset, links *linking.Chain, observers ...issuer.Observer) (*issuer.Issuer, error) {
+	tsaSigner, tsaSignerHash, err := newSigner(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	certChain, err := loadCertChain(cfg, tsaSigner)
+	if err != nil {
+		return nil, err
+	}
+	if err := tsx509.VerifyCertChain(certChain, tsaSigner); err != nil {
+		return nil, err
+	}
+	return newIssuerWithSigner(cfg, tsaSigner, tsaSignerHash, certChain, clockOffset, links, observers...)
+}
+
+// newIssuerWithSigner creates the timestamp issuer for an already loaded
+// signer and certificate chain.
+func newIssuerWithSigner(cfg *config.Config, tsaSigner crypto.Signer, tsaSignerHash crypto.Hash, certChain []*x509.Certificate, clockOffset issuer.ClockOffset, links *linking.Chain, observers ...issuer.Observer) (*issuer.Issuer, error) {
+	defaultPolicy, err := cfg.Policies.DefaultPolicy()
+	if err != nil {
+		return nil, err
+	}
+	acceptedPolicies, err := cfg.Policies.AcceptedPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := issuer.Options{
+		Signer:                       tsaSigner,
+		SignerHash:                   tsaSignerHash,
+		CertChain:                    certChain,
+		DefaultPolicy:                defaultPolicy,
+		AcceptedPolicies:             acceptedPolicies,
+		RequireCertificatePolicy:     cfg.Policies.RequireCertificatePolicy,
+		Accuracy:                     cfg.Policies.Accuracy,
+		ExpiryWarningWindow:          cfg.Chain.ExpiryWarning,
+		FailReadinessOnExpiryWarning: cfg.Chain.ExpiryFailReadiness,
+		Observers:                    observers,
+		SignerBackend:                signerBackend(cfg),
+		VerifyAfterSign:              cfg.Signer.VerifyAfterSign,
+		TripOnVerifyFailure:          cfg.Signer.TripOnVerifyFailure,
+	}
+	if cfg.NTP.CorrectTime {
+		opts.ClockOffset = clockOffset
+		opts.MaxClockCorrection = cfg.NTP.MaxCorrection
+	}
+	if links != nil {
+		opts.Linker = links
+	}
+	return issuer.New(opts)
+}
+
+// openLinks opens the chain of linked timestamps, or returns nil when linking
+// is disabled.
+func openLinks(cfg *config.Config) (*linking.Chain, error) {
+	if cfg.Linking.Path == "" {
+		return nil, nil
+	}
+	links, err := linking.Open(cfg.Linking.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening link chain")
+	}
+	return links, nil
+}
+
+// signerBackend names the signer in traces, including the KMS provider.
+func signerBackend(cfg *config.Config) string {
+	if cfg.Signer.Type == signer.KMSScheme {
+		if scheme, _, ok := strings.Cut(cfg.Signer.KMS.KeyResource, "://"); ok {
+			return cfg.Signer.Type + "/" + scheme
+		}
+	}
+	return cfg.Signer.Type
+}
+
+// apiOptions returns the options of the API for the configuration.
+func apiOptions(cfg *config.Config, historicalChains []trustedroot.Chain, links *linking.Chain) []api.Option {
+	opts := []api.Option{api.WithURI(cfg.TrustedRoot.URI), api.WithHistoricalChains(historicalChains)}
+	if cfg.Artifacts.Enabled {
+		opts = append(opts, api.WithArtifactHashing(cfg.Artifacts.MaxSize))
+	}
+	if links != nil {
+		opts = append(opts, api.WithLinks(links))
+	}
+	return opts
+}
@@ -0,0 +1,213 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sigstore/timestamp-authority/pkg/config"
+	"github.com/sigstore/timestamp-authority/pkg/ntpmonitor"
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+)
+
+var errSkipped = errors.New("skipped")
+
+// preflight writes a pass/fail line for each startup step it runs and counts
+// the failures.
+type preflight struct {
+	w      io.Writer
+	failed int
+}
+
+func (p *preflight) step(name string, run func() error) bool {
+	err := run()
+	switch {
+	case errors.Is(err, errSkipped):
+		fmt.Fprintf(p.w, "SKIP  %s\n", name)
+		return true
+	case err != nil:
+		p.failed++
+		fmt.Fprintf(p.w, "FAIL  %s\n", name)
+		for _, e := range config.Errors(err) {
+			fmt.Fprintf(p.w, "        %v\n", e)
+		}
+		return false
+	default:
+		fmt.Fprintf(p.w, "PASS  %s\n", name)
+		return true
+	}
+}
+
+func (p *preflight) skip(name string) {
+	fmt.Fprintf(p.w, "SKIP  %s\n", name)
+}
+
+// runPreflight runs the startup steps of the server without listening on any
+// port, returning the number of failed steps. Steps that depend on a failed
+// step are skipped.
+func runPreflight(ctx context.Context, w io.Writer, configPath string) int {
+	p := &preflight{w: w}
+
+	var cfg *config.Config
+	if !p.step("load configuration", func() error {
+		var err error
+		cfg, err = loadConfig(configPath)
+		return err
+	}) {
+		return p.failed
+	}
+
+	var tsaSigner crypto.Signer
+	var tsaSignerHash crypto.Hash
+	var certChain []*x509.Certificate
+	signerOK := p.step(fmt.Sprintf("load %s signer", cfg.Signer.Type), func() error {
+		var err error
+		tsaSigner, tsaSignerHash, err = newSigner(ctx, cfg)
+		return err
+	})
+	chainOK := signerOK && p.step("load certificate chain", func() error {
+		var err error
+		certChain, err = loadCertChain(cfg, tsaSigner)
+		return err
+	})
+	chainOK = chainOK && p.step("verify certificate chain", func() error {
+		return tsx509.VerifyCertChain(certChain, tsaSigner)
+	})
+
+	if chainOK {
+		p.step("test sign and verify", func() error {
+			return testSignAndVerify(ctx, cfg, tsaSigner, tsaSignerHash, certChain)
+		})
+		p.step("certificate expiry", func() error {
+			if err := tsx509.VerifyCertChainValidity(certChain, time.Now()); err != nil {
+				return err
+			}
+			i, err := newIssuerWithSigner(cfg, tsaSigner, tsaSignerHash, certChain, nil, nil)
+			if err != nil {
+				return err
+			}
+			return i.CheckCertificateExpiry()
+		})
+	} else {
+		if !signerOK {
+			p.skip("load certificate chain")
+			p.skip("verify certificate chain")
+		}
+		p.skip("test sign and verify")
+		p.skip("certificate expiry")
+	}
+
+	p.step("load historical certificate chains", func() error {
+		if len(cfg.Chain.History) == 0 {
+			return errSkipped
+		}
+		_, err := loadHistoricalChains(cfg)
+		return err
+	})
+
+	p.step("query ntp servers", func() error {
+		if cfg.NTP.Disabled {
+			return errSkipped
+		}
+		ntpm, err := ntpmonitor.New(cfg.NTP.ConfigPath)
+		if err != nil {
+			return err
+		}
+		return ntpm.Check()
+	})
+
+	return p.failed
+}
+
+// testSignAndVerify issues a timestamp for a random digest and verifies it
+// against the certificate chain.
+func testSignAndVerify(ctx context.Context, cfg *config.Config, tsaSigner crypto.Signer, tsaSignerHash crypto.Hash, certChain []*x509.Certificate) error {
+	i, err := newIssuerWithSigner(cfg, tsaSigner, tsaSignerHash, certChain, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	artifact := make([]byte, 32)
+	if _, err := rand.Read(artifact); err != nil {
+		return err
+	}
+	digest := crypto.SHA256.New()
+	digest.Write(artifact)
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return err
+	}
+	resp, err := i.Issue(ctx, &timestamp.Request{
+		HashAlgorithm: crypto.SHA256,
+		HashedMessage: digest.Sum(nil),
+		Nonce:         nonce,
+		Certificates:  true,
+	})
+	if err != nil {
+		return fmt.Errorf("signing: %w", err)
+	}
+
+	opts := verification.VerifyOpts{
+		TSACertificate: certChain[0],
+		Intermediates:  certChain[1 : len(certChain)-1],
+		Roots:          certChain[len(certChain)-1:],
+		Nonce:          nonce,
+	}
+	if _, err := verification.VerifyTimestampResponse(resp, bytes.NewReader(artifact), opts); err != nil {
+		return fmt.Errorf("verifying: %w", err)
+	}
+	return nil
+}
+
+var preflightCmd = &cobra.Command{
+	Use:   "preflight",
+	Short: "check the server configuration without serving",
+	Long: `Performs every startup step of the server without listening on a port: validates the
+configuration, loads the signer and certificate chain, verifies the chain, signs and verifies a test
+timestamp, checks certificate expiry and queries the configured NTP servers once. Exits non-zero if
+any check fails.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return err
+		}
+		if failed := runPreflight(cmd.Context(), cmd.OutOrStdout(), viper.GetString("server-config")); failed > 0 {
+			return fmt.Errorf("preflight failed: %d check(s) failed", failed)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "preflight passed")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(preflightCmd)
+}
@@ -0,0 +1,195 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"go.step.sm/crypto/pemutil"
+
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+// writeTestFile writes data to name in dir, returning its path.
+func writeTestFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPreflight(t *testing.T) {
+	dir := t.TempDir()
+
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, leafKey, _ := testutils.GenerateLeafCert(subCert, subKey)
+	_, otherKey, _ := testutils.GenerateLeafCert(subCert, subKey)
+
+	pemKey := func(name string, key crypto.PrivateKey) string {
+		block, err := pemutil.Serialize(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return writeTestFile(t, dir, name, pem.EncodeToMemory(block))
+	}
+	pemChain := func(name string, certs ...*x509.Certificate) string {
+		data, err := cryptoutils.MarshalCertificatesToPEM(certs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return writeTestFile(t, dir, name, data)
+	}
+	keyPath := pemKey("key.pem", leafKey)
+	otherKeyPath := pemKey("other-key.pem", otherKey)
+	chainPath := pemChain("chain.pem", leafCert, subCert, rootCert)
+	// the intermediate certificate is missing
+	brokenChainPath := pemChain("broken-chain.pem", leafCert, rootCert)
+	// nothing answers NTP queries on the loopback address
+	ntpPath := writeTestFile(t, dir, "ntp.yaml", []byte(`
+request_attempts: 1
+request_timeout: 1
+num_servers: 1
+max_time_delta: 1
+server_threshold: 1
+period: 60
+servers: [127.0.0.1]
+`))
+
+	for _, tc := range []struct {
+		name    string
+		key     string
+		chain   string
+		extra   string
+		failed  int
+		results []string
+	}{
+		{
+			name:   "pass",
+			key:    keyPath,
+			chain:  chainPath,
+			extra:  "  expiry_warning: 1m\nntp:\n  disabled: true\n",
+			failed: 0,
+			results: []string{
+				"PASS  load configuration",
+				"PASS  load file signer",
+				"PASS  load certificate chain",
+				"PASS  verify certificate chain",
+				"PASS  test sign and verify",
+				"PASS  certificate expiry",
+				"SKIP  load historical certificate chains",
+				"SKIP  query ntp servers",
+			},
+		},
+		{
+			name:   "bad chain",
+			key:    keyPath,
+			chain:  brokenChainPath,
+			extra:  "  expiry_warning: 1m\nntp:\n  disabled: true\n",
+			failed: 1,
+			results: []string{
+				"PASS  load certificate chain",
+				"FAIL  verify certificate chain",
+				"SKIP  test sign and verify",
+				"SKIP  certificate expiry",
+			},
+		},
+		{
+			name:   "key mismatch",
+			key:    otherKeyPath,
+			chain:  chainPath,
+			extra:  "  expiry_warning: 1m\nntp:\n  disabled: true\n",
+			failed: 1,
+			results: []string{
+				"PASS  load file signer",
+				"FAIL  verify certificate chain",
+				"SKIP  test sign and verify",
+			},
+		},
+		{
+			// the leaf certificate expires within the default warning window
+			name:   "expiring certificate",
+			key:    keyPath,
+			chain:  chainPath,
+			extra:  "ntp:\n  disabled: true\n",
+			failed: 1,
+			results: []string{
+				"PASS  test sign and verify",
+				"FAIL  certificate expiry",
+			},
+		},
+		{
+			name:   "ntp failure",
+			key:    keyPath,
+			chain:  chainPath,
+			extra:  "  expiry_warning: 1m\nntp:\n  config_path: " + ntpPath + "\n",
+			failed: 1,
+			results: []string{
+				"PASS  certificate expiry",
+				"FAIL  query ntp servers",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			configPath := writeTestFile(t, t.TempDir(), "config.yaml", []byte(`version: v1
+signer:
+  type: file
+  file:
+    key_path: `+tc.key+`
+chain:
+  path: `+tc.chain+`
+`+tc.extra))
+
+			var out bytes.Buffer
+			if failed := runPreflight(context.Background(), &out, configPath); failed != tc.failed {
+				t.Fatalf("expected %d failed checks, got %d:\n%s", tc.failed, failed, out.String())
+			}
+			lines := strings.Split(out.String(), "\n")
+			for _, r := range tc.results {
+				found := false
+				for _, l := range lines {
+					found = found || l == r
+				}
+				if !found {
+					t.Fatalf("expected %q in the report:\n%s", r, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestPreflightInvalidConfiguration(t *testing.T) {
+	configPath := writeTestFile(t, t.TempDir(), "config.yaml", []byte("version: v0\n"))
+
+	var out bytes.Buffer
+	if failed := runPreflight(context.Background(), &out, configPath); failed != 1 {
+		t.Fatalf("expected 1 failed check, got %d:\n%s", failed, out.String())
+	}
+	if !strings.HasPrefix(out.String(), "FAIL  load configuration\n        version:") {
+		t.Fatalf("expected the configuration errors to be reported, got:\n%s", out.String())
+	}
+}
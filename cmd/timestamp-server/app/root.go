@@ -18,8 +18,10 @@ package app
 import (
 	"fmt"
 	"os"
+	"time"
 
 	homedir "github.com/mitchellh/go-homedir"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
 	"github.com/sigstore/timestamp-authority/pkg/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -55,11 +57,14 @@ func init() {
 	cobra.OnInitialize(initConfig)
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.timestamp-server.yaml)")
+	rootCmd.PersistentFlags().String("server-config", "", "Path to a versioned server configuration file. When set, it replaces the signer, certificate chain, NTP, listener, TLS, metrics, policy, trusted root, audit, tracing, admin, shutdown, artifact hashing, gRPC, linking and Roughtime flags")
 	rootCmd.PersistentFlags().StringVar(&logType, "log-type", "dev", "logger type to use (dev/prod)")
 	rootCmd.PersistentFlags().BoolVar(&enablePprof, "enable-pprof", false, "enable pprof for profiling on port 6060")
 	rootCmd.PersistentFlags().BoolVar(&httpPingOnly, "http-ping-only", false, "serve only /ping in the http server")
 	rootCmd.PersistentFlags().String("timestamp-signer", "memory", "Timestamping authority signer. Valid options include: [kms, tink, memory, file]. Memory and file-based signers should only be used for testing")
 	rootCmd.PersistentFlags().String("timestamp-signer-hash", "sha256", "Hash algorithm used by the signer. Must match the hash algorithm specified for a KMS or Tink key. Valid options include: [sha256, sha384, sha512]. Ignored for Memory signer.")
+	rootCmd.PersistentFlags().Bool("verify-after-sign", false, "Verify every signed timestamp response before returning it, failing the request if the signature is faulty")
+	rootCmd.PersistentFlags().Bool("trip-on-verify-failure", false, "Stop signing and fail readiness once a response fails verification, until the server is reloaded or restarted. Requires --verify-after-sign")
 	// KMS flags
 	rootCmd.PersistentFlags().String("kms-key-resource", "", "KMS key for signing timestamp responses. Valid options include: [gcpkms://resource, azurekms://resource, hashivault://resource, awskms://resource]")
 	// Tink flags
@@ -71,9 +76,55 @@ func init() {
 	// File flags
 	rootCmd.PersistentFlags().String("file-signer-key-path", "", "Path to file containing PEM-encoded private key. Supported formats include PKCS#1, PKCS#8, and RFC5915 for EC")
 	rootCmd.PersistentFlags().String("file-signer-passwd", "", "Password to decrypt private key")
+	// Certificate expiry
+	rootCmd.PersistentFlags().StringSlice("certificate-chain-history", []string{}, "Paths to PEM-encoded certificate chains previously used by the server, included in the exported trusted root")
+	rootCmd.PersistentFlags().Bool("require-certificate-policy", false, "Only issue timestamps under policies listed in the certificatePolicies extension of the leaf certificate, failing at startup if the default policy is not listed")
+	rootCmd.PersistentFlags().String("trusted-root-uri", "", "URI identifying the timestamp authority in the exported trusted root. Defaults to the timestamp endpoint of the host serving the request")
+
+	rootCmd.PersistentFlags().Duration("certificate-expiry-warning", 30*24*time.Hour, "Warn when a certificate in the chain expires within this duration. Set to 0 to disable")
+	rootCmd.PersistentFlags().Bool("certificate-expiry-fail-readiness", false, "Fail the /ready endpoint while a certificate in the chain is within the expiry warning window")
+	// Audit log
+	rootCmd.PersistentFlags().String("audit-log-path", "", "Path of a hash-chained audit log recording every timestamp request. Disabled when empty")
+	rootCmd.PersistentFlags().Duration("audit-checkpoint-interval", time.Hour, "How often the head of the audit log is timestamped")
+	rootCmd.PersistentFlags().Bool("audit-fail-closed", false, "Withhold a timestamp that cannot be recorded in the audit log, and stop issuing until the configuration is reloaded or the server restarted")
+	// Tracing
+	rootCmd.PersistentFlags().String("tracing-exporter", "none", "OpenTelemetry trace exporter. Valid options include: [none, otlp, stdout, file]")
+	rootCmd.PersistentFlags().String("tracing-endpoint", "", "URL of the OTLP/HTTP collector receiving traces. Defaults to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable")
+	rootCmd.PersistentFlags().String("tracing-file", "", "Path of the file traces are written to by the file exporter")
+	rootCmd.PersistentFlags().Float64("tracing-sample-ratio", 1, "Fraction of traces not started by a caller that are sampled")
+	// Unix listener, whose path is set with --socket-path
+	rootCmd.PersistentFlags().String("socket-mode", "0660", "Octal permissions of the unix socket")
+	rootCmd.PersistentFlags().String("socket-owner", "", "Owner of the unix socket, as a user name or ID")
+	rootCmd.PersistentFlags().String("socket-group", "", "Group of the unix socket, as a group name or ID")
+	// TLS policy of the https listener, configured with --tls-certificate, --tls-key and --tls-ca
+	rootCmd.PersistentFlags().String("tls-client-auth", "", "Client certificate policy of the https listener. Valid options include: [none, optional, require]. Defaults to require when --tls-ca is set, and none otherwise")
+	rootCmd.PersistentFlags().String("tls-min-version", "1.2", "Minimum TLS version of the https listener. Valid options include: [1.2, 1.3]")
+	// Admin server
+	rootCmd.PersistentFlags().String("admin-address", "", "Address of the admin server serving metrics, profiles and runtime controls. Disabled when empty")
+	rootCmd.PersistentFlags().String("admin-token-file", "", "Path to a file holding the bearer token required by the admin server")
+	rootCmd.PersistentFlags().String("admin-tls-certificate", "", "Path to the PEM-encoded certificate served by the admin server. Plain HTTP when empty")
+	rootCmd.PersistentFlags().String("admin-tls-key", "", "Path to the PEM-encoded key of the admin server certificate")
+	// Shutdown
+	rootCmd.PersistentFlags().Duration("shutdown-readiness-delay", 0, "How long readiness fails on SIGTERM before new timestamp requests are refused")
+	rootCmd.PersistentFlags().Duration("shutdown-grace-period", 15*time.Second, "How long in-flight requests are given to complete on SIGTERM once new timestamp requests are refused")
+	// Artifact hashing
+	rootCmd.PersistentFlags().Bool("enable-artifact-hashing", false, "Serve /api/v1/timestamp/artifact, which hashes an uploaded artifact and timestamps its digest")
+	rootCmd.PersistentFlags().Int64("artifact-max-size", 32<<20, "Maximum size in bytes of an artifact uploaded for hashing")
+	// gRPC API
+	rootCmd.PersistentFlags().String("grpc-address", "", "Address of the gRPC API, e.g. localhost:3001. Disabled when empty")
+	rootCmd.PersistentFlags().Bool("grpc-tls", false, "Serve the gRPC API over TLS, with the certificate, key and client authentication of the https listener")
+	// Linked timestamps
+	rootCmd.PersistentFlags().String("linking-path", "", "Path of the chain linking every issued timestamp to the ones before it, published at /api/v1/timestamp/links. Disabled when empty")
+	// Roughtime
+	rootCmd.PersistentFlags().String("roughtime-address", "", "UDP address of the Roughtime responder, e.g. :2002. Disabled when empty")
+	rootCmd.PersistentFlags().String("roughtime-key-path", "", "Path to the PEM-encoded PKCS#8 Ed25519 long-term Roughtime key. Defaults to the timestamp signer when it is an Ed25519 key, or to an in-memory key with the memory signer")
+	rootCmd.PersistentFlags().Duration("roughtime-radius", time.Second, "Accuracy claimed in Roughtime responses, rounded up to seconds")
+	rootCmd.PersistentFlags().Duration("roughtime-delegation-validity", 24*time.Hour, "How long a delegated Roughtime key is certified for by the long-term key")
 	// NTP time introspection
 	rootCmd.PersistentFlags().String("ntp-monitoring", "", "Path to a file configuring ntp monitoring. Uses pkg/ntpmonitor/ntpsync.yaml as the default configuration if none is provided")
 	rootCmd.PersistentFlags().Bool("disable-ntp-monitoring", false, "Disables NTP monitoring. Defaults to false")
+	rootCmd.PersistentFlags().Bool("ntp-correct-time", false, "Correct the generation time of timestamps with the consensus offset of the NTP servers, while the local time is in sync with them")
+	rootCmd.PersistentFlags().Duration("ntp-max-correction", issuer.DefaultMaxClockCorrection, "Maximum correction applied to the generation time by --ntp-correct-time")
 
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		log.Logger.Fatal(err)
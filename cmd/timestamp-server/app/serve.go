@@ -16,26 +16,57 @@
 package app
 
 import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
 	"flag"
+	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
 
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 	"sigs.k8s.io/release-utils/version"
 
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/audit"
+	"github.com/sigstore/timestamp-authority/pkg/config"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/linking"
 	"github.com/sigstore/timestamp-authority/pkg/log"
 	"github.com/sigstore/timestamp-authority/pkg/ntpmonitor"
 	"github.com/sigstore/timestamp-authority/pkg/server"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
 )
 
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "start http server with configured api",
-	Long:  `Starts a http server and serves the configured api`,
-	Run: func(cmd *cobra.Command, _ []string) {
+	Long: `Starts a http server and serves the configured api.
+
+On SIGINT or SIGTERM, readiness fails at once, new timestamp requests are refused after the
+shutdown readiness delay, and in-flight requests are given the shutdown grace period to complete
+before the server stops.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
-			log.Logger.Fatal(err)
+			return err
 		}
 		// Setup the logger to dev/prod
 		log.ConfigureLogger(viper.GetString("log-type"))
@@ -51,70 +82,444 @@ var serveCmd = &cobra.Command{
 		}
 		log.Logger.Infof("starting timestamp-server @ %v", viStr)
 
-		// create the prometheus, pprof, and rest API servers
-
-		readTimeout := viper.GetDuration("read-timeout")
-		writeTimeout := viper.GetDuration("write-timeout")
-
-		go func() {
-			promServer := server.NewPrometheusServer(readTimeout, writeTimeout)
+		cfg, err := loadConfig(viper.GetString("server-config"))
+		if err != nil {
+			for _, e := range config.Errors(err) {
+				log.Logger.Error(e)
+			}
+			return errors.New("invalid server configuration")
+		}
 
-			if err := promServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-				log.Logger.Fatalf("error when starting or running http server for metrics: %v", err)
+		shutdownTracing, err := tracing.Setup(cmd.Context(), tracing.Options{
+			Exporter:    cfg.Tracing.Exporter,
+			Endpoint:    cfg.Tracing.Endpoint,
+			Path:        cfg.Tracing.Path,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			return fmt.Errorf("setting up tracing: %w", err)
+		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				log.Logger.Error(err)
 			}
 		}()
+		log.Logger.Infof("tracing exporter: %s", cfg.Tracing.Exporter)
 
-		enablePprof := viper.GetBool("enable-pprof")
-		log.Logger.Debugf("pprof enabled: %v", enablePprof)
-		// Enable pprof
-		if enablePprof {
-			go func() {
-				pprofServer := server.NewPprofServer(readTimeout, writeTimeout)
-
-				if err := pprofServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-					log.Logger.Fatalf("error when starting or running http server for pprof: %v", err)
+		observers := []issuer.Observer{api.MetricsObserver}
+		var auditLog *audit.Log
+		if cfg.Audit.Path != "" {
+			auditLog, err = audit.Open(cfg.Audit.Path)
+			if err != nil {
+				return fmt.Errorf("opening audit log: %w", err)
+			}
+			defer func() {
+				if err := auditLog.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+			auditLog.OnWriteFailure(auditWriteFailed(cfg, api.Issuer))
+			auditLog.SetFailClosed(cfg.Audit.FailClosed)
+			observers = append(observers, auditLog)
+		}
+		links, err := openLinks(cfg)
+		if err != nil {
+			return err
+		}
+		if links != nil {
+			defer func() {
+				if err := links.Close(); err != nil {
+					log.Logger.Error(err)
 				}
 			}()
 		}
 
+		// the ntp monitor outlives reloads, and provides the clock offset of every issuer
 		var ntpm *ntpmonitor.NTPMonitor
-		disableNTPMonitoring := viper.GetBool("disable-ntp-monitoring")
-		if disableNTPMonitoring {
+		var clockOffset issuer.ClockOffset
+		if cfg.NTP.Disabled {
 			log.Logger.Info("ntp monitoring disabled")
 		} else {
-			ntpMonitoring := viper.GetString("ntp-monitoring")
+			ntpMonitoring := cfg.NTP.ConfigPath
 			if ntpMonitoring != "" {
 				log.Logger.Infof("using custom ntp monitoring config: %s", ntpMonitoring)
 			}
+			ntpm, err = ntpmonitor.New(ntpMonitoring)
+			if err != nil {
+				return fmt.Errorf("initializing ntp monitor: %w", err)
+			}
+			clockOffset = ntpm
+		}
 
-			go func() {
-				ntpm, err = ntpmonitor.New(ntpMonitoring)
-				if err != nil {
-					log.Logger.Fatalf("error initializing ntp monitor %s", err)
-				}
+		tsaIssuer, err := newIssuer(cmd.Context(), cfg, clockOffset, links, observers...)
+		if err != nil {
+			return fmt.Errorf("creating timestamp issuer: %w", err)
+		}
+
+		historicalChains, err := loadHistoricalChains(cfg)
+		if err != nil {
+			return fmt.Errorf("loading historical certificate chains: %w", err)
+		}
+
+		readTimeout := cfg.Listeners.ReadTimeout
+		writeTimeout := cfg.Listeners.WriteTimeout
 
-				ntpm.Start()
+		apiServer := server.NewRestAPIServer(cfg.Listeners.Host, cfg.Listeners.Port, cfg.Listeners.Schemes, cfg.Listeners.HTTPPingOnly, readTimeout, writeTimeout, tsaIssuer,
+			apiOptions(cfg, historicalChains, links)...)
+		apiServer.TLSHost = cfg.TLS.Host
+		apiServer.TLSPort = cfg.TLS.Port
+		if slices.Contains(cfg.Listeners.Schemes, "https") {
+			if err := server.ConfigureTLS(apiServer, server.TLSOptions{
+				Certificate: cfg.TLS.Certificate,
+				Key:         cfg.TLS.Key,
+				ClientCA:    cfg.TLS.CACertificate,
+				ClientAuth:  cfg.TLS.ClientAuth,
+				MinVersion:  cfg.TLS.MinVersion,
+			}); err != nil {
+				return fmt.Errorf("configuring TLS: %w", err)
+			}
+		}
+		// the listeners are stopped once in-flight timestamp requests complete,
+		// sharing the deadline they were given
+		apiServer.GracefulTimeout = cfg.Shutdown.ReadinessDelay + cfg.Shutdown.GracePeriod
+		api.ConfigureShutdown(cfg.Shutdown.ReadinessDelay, cfg.Shutdown.GracePeriod)
+
+		if auditLog != nil {
+			// runs after the background checkpoints stop
+			defer func() {
+				if err := auditLog.Checkpoint(context.Background(), currentIssuerStamper); err != nil {
+					log.Logger.Error(err)
+				}
 			}()
 		}
 
-		host := viper.GetString("host")
-		port := int(viper.GetUint("port"))
-		scheme := viper.GetStringSlice("scheme")
-		server := server.NewRestAPIServer(host, port, scheme, httpPingOnly, readTimeout, writeTimeout)
+		// background tasks stop when ctx is done, once the API server has shut down
+		ctx, cancel := context.WithCancel(cmd.Context())
+		var background sync.WaitGroup
 		defer func() {
-			if err := server.Shutdown(); err != nil {
+			cancel()
+			background.Wait()
+		}()
+		// the first background server to fail stops the API server
+		failed := make(chan error, 1)
+		fail := func(err error) {
+			select {
+			case failed <- err:
+			default:
+			}
+			if err := apiServer.Shutdown(); err != nil {
 				log.Logger.Error(err)
 			}
-			if ntpm != nil {
-				ntpm.Stop()
+		}
+		run := func(name string, srv *http.Server, listen func() error) {
+			background.Add(2)
+			go func() {
+				defer background.Done()
+				if err := listen(); err != nil && err != http.ErrServerClosed {
+					fail(fmt.Errorf("starting or running http server for %s: %w", name, err))
+				}
+			}()
+			go func() {
+				defer background.Done()
+				<-ctx.Done()
+				if err := srv.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+		}
+
+		// create the prometheus, pprof, and admin servers
+
+		promServer := server.NewPrometheusServer(cfg.Metrics.Address, readTimeout, writeTimeout)
+		run("metrics", promServer, promServer.ListenAndServe)
+
+		enablePprof := cfg.Metrics.Pprof.Enabled
+		log.Logger.Debugf("pprof enabled: %v", enablePprof)
+		// Enable pprof
+		if enablePprof {
+			pprofServer := server.NewPprofServer(cfg.Metrics.Pprof.Address, readTimeout, writeTimeout)
+			run("pprof", pprofServer, pprofServer.ListenAndServe)
+		}
+
+		reload := reloader(clockOffset, links, observers)
+		if cfg.Admin.Address != "" {
+			adminServer, err := newAdminServer(cfg, reload)
+			if err != nil {
+				return err
+			}
+			listen := adminServer.ListenAndServe
+			if adminServer.TLSConfig != nil {
+				listen = func() error { return adminServer.ListenAndServeTLS("", "") }
+			}
+			run("admin", adminServer, listen)
+			log.Logger.Infof("admin server listening on %s", cfg.Admin.Address)
+		}
+
+		if cfg.GRPC.Address != "" {
+			grpcServer, err := newGRPCServer(cfg)
+			if err != nil {
+				return err
+			}
+			lis, err := net.Listen("tcp", cfg.GRPC.Address)
+			if err != nil {
+				return fmt.Errorf("listening for grpc: %w", err)
+			}
+			background.Add(2)
+			go func() {
+				defer background.Done()
+				if err := grpcServer.Serve(lis); err != nil {
+					fail(fmt.Errorf("starting or running grpc server: %w", err))
+				}
+			}()
+			go func() {
+				defer background.Done()
+				<-ctx.Done()
+				grpcServer.GracefulStop()
+			}()
+			log.Logger.Infof("grpc server listening on %s", cfg.GRPC.Address)
+		}
+
+		if cfg.Roughtime.Address != "" {
+			roughtimeServer, err := newRoughtimeServer(cmd.Context(), cfg, clockOffset)
+			if err != nil {
+				return fmt.Errorf("creating roughtime responder: %w", err)
+			}
+			conn, err := net.ListenPacket("udp", cfg.Roughtime.Address)
+			if err != nil {
+				return fmt.Errorf("listening for roughtime: %w", err)
+			}
+			background.Add(2)
+			go func() {
+				defer background.Done()
+				if err := roughtimeServer.Serve(conn); err != nil {
+					fail(fmt.Errorf("running roughtime responder: %w", err))
+				}
+			}()
+			go func() {
+				defer background.Done()
+				<-ctx.Done()
+				if err := conn.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+			log.Logger.Infof("roughtime responder listening on %s with public key %s",
+				conn.LocalAddr(), base64.StdEncoding.EncodeToString(roughtimeServer.PublicKey()))
+		}
+
+		if ntpm != nil {
+			background.Add(1)
+			go func() {
+				defer background.Done()
+				ntpm.Run(ctx)
+			}()
+		}
+
+		if links != nil {
+			log.Logger.Infof("linking timestamps in %s", cfg.Linking.Path)
+		}
+		if auditLog != nil {
+			log.Logger.Infof("writing audit log to %s, checkpointed every %v", cfg.Audit.Path, cfg.Audit.CheckpointInterval)
+			background.Add(1)
+			go func() {
+				defer background.Done()
+				auditLog.Run(ctx, cfg.Audit.CheckpointInterval, currentIssuerStamper)
+			}()
+		}
+
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			defer signal.Stop(hup)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-hup:
+					if err := reload(ctx); err != nil {
+						log.Logger.Errorf("error reloading configuration: %v", err)
+					}
+				}
 			}
 		}()
-		if err := server.Serve(); err != nil {
-			log.Logger.Fatal(err)
+
+		// the mode is checked when validating the configuration
+		socketMode, _ := cfg.Listeners.Socket.FileMode()
+		if err := server.Listen(apiServer, server.SocketOptions{
+			Path:  cfg.Listeners.Socket.Path,
+			Mode:  socketMode,
+			Owner: cfg.Listeners.Socket.Owner,
+			Group: cfg.Listeners.Socket.Group,
+		}); err != nil {
+			return fmt.Errorf("listening: %w", err)
+		}
+		if err := apiServer.Serve(); err != nil {
+			return err
+		}
+		select {
+		case err := <-failed:
+			return err
+		default:
+			log.Logger.Info("timestamp-server stopped")
+			return nil
 		}
 	},
 }
 
+// auditWriteFailed counts the requests whose audit log record cannot be
+// written and, when the audit log fails closed, trips the issuer returned by
+// current, so that later requests are refused. The request whose record
+// failed is withheld by the audit log itself, see audit.Log.SetFailClosed.
+func auditWriteFailed(cfg *config.Config, current func() *issuer.Issuer) func(error) {
+	return func(err error) {
+		api.MetricAuditWriteFailures.Inc()
+		if cfg.Audit.FailClosed {
+			if i := current(); i != nil {
+				i.Trip(err)
+			}
+		}
+	}
+}
+
+// currentIssuerStamper timestamps audit log checkpoints with the issuer
+// currently serving the API, which changes when the configuration is reloaded.
+func currentIssuerStamper(ctx context.Context, digest []byte) ([]byte, error) {
+	return audit.IssuerStamper(api.Issuer())(ctx, digest)
+}
+
+// reloader returns a function that reloads the configuration and replaces the
+// issuer serving the API, picking up a new signing key, certificate chain,
+// historical chains, policies or trusted root URI. Other settings, such as the
+// listeners, the audit log or the link chain, only take effect on restart. The
+// running issuer is kept if the new configuration is invalid.
+func reloader(clockOffset issuer.ClockOffset, links *linking.Chain, observers []issuer.Observer) server.ReloadFunc {
+	var mu sync.Mutex
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// the signer outlives the request triggering the reload
+		ctx = context.WithoutCancel(ctx)
+		cfg, err := loadConfig(viper.GetString("server-config"))
+		if err != nil {
+			return err
+		}
+		tsaIssuer, err := newIssuer(ctx, cfg, clockOffset, links, observers...)
+		if err != nil {
+			return err
+		}
+		historicalChains, err := loadHistoricalChains(cfg)
+		if err != nil {
+			return err
+		}
+		if err := api.Reconfigure(tsaIssuer, apiOptions(cfg, historicalChains, links)...); err != nil {
+			return err
+		}
+		log.Logger.Info("configuration reloaded")
+		return nil
+	}
+}
+
+// newAdminServer creates the admin server, reading its bearer token and TLS
+// certificate.
+func newAdminServer(cfg *config.Config, reload server.ReloadFunc) (*http.Server, error) {
+	var token string
+	if cfg.Admin.TokenFile != "" {
+		b, err := os.ReadFile(filepath.Clean(cfg.Admin.TokenFile))
+		if err != nil {
+			return nil, fmt.Errorf("reading admin token: %w", err)
+		}
+		token = strings.TrimSpace(string(b))
+	} else {
+		log.Logger.Warn("admin server does not require a token, anyone who can reach it can drain and reload the server")
+	}
+	adminServer := server.NewAdminServer(cfg.Admin.Address, cfg.Listeners.ReadTimeout, cfg.Listeners.WriteTimeout, token, reload)
+	if cfg.Admin.TLS.Certificate != "" {
+		tlsConfig, err := server.NewTLSConfig(server.TLSOptions{Certificate: cfg.Admin.TLS.Certificate, Key: cfg.Admin.TLS.Key})
+		if err != nil {
+			return nil, fmt.Errorf("configuring admin TLS: %w", err)
+		}
+		adminServer.TLSConfig = tlsConfig
+	}
+	return adminServer, nil
+}
+
+// newGRPCServer creates the gRPC server, sharing the TLS configuration of the
+// https listener when TLS is enabled.
+func newGRPCServer(cfg *config.Config) (*grpc.Server, error) {
+	var tlsConfig *tls.Config
+	if cfg.GRPC.TLS {
+		var err error
+		tlsConfig, err = server.NewTLSConfig(server.TLSOptions{
+			Certificate: cfg.TLS.Certificate,
+			Key:         cfg.TLS.Key,
+			ClientCA:    cfg.TLS.CACertificate,
+			ClientAuth:  cfg.TLS.ClientAuth,
+			MinVersion:  cfg.TLS.MinVersion,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("configuring grpc TLS: %w", err)
+		}
+	}
+	return server.NewGRPCServer(tlsConfig), nil
+}
+
+// newRoughtimeServer creates the Roughtime responder, corrected with the
+// clock offset like the issuer, and answering only while the NTP monitor
+// finds the local time in sync when monitoring is enabled.
+func newRoughtimeServer(ctx context.Context, cfg *config.Config, clockOffset issuer.ClockOffset) (*server.RoughtimeServer, error) {
+	key, err := roughtimeKey(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return server.NewRoughtimeServer(server.RoughtimeOptions{
+		Signer:             key,
+		Radius:             cfg.Roughtime.Radius,
+		DelegationValidity: cfg.Roughtime.DelegationValidity,
+		ClockOffset:        clockOffset,
+		CorrectTime:        cfg.NTP.CorrectTime,
+		MaxClockCorrection: cfg.NTP.MaxCorrection,
+	})
+}
+
+// roughtimeKey loads the long-term Roughtime key, falling back to the
+// timestamp signer when it is an Ed25519 key.
+func roughtimeKey(ctx context.Context, cfg *config.Config) (crypto.Signer, error) {
+	if cfg.Roughtime.KeyPath != "" {
+		b, err := os.ReadFile(filepath.Clean(cfg.Roughtime.KeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("reading roughtime key: %w", err)
+		}
+		key, err := cryptoutils.UnmarshalPEMToPrivateKey(b, cryptoutils.SkipPassword)
+		if err != nil {
+			return nil, fmt.Errorf("parsing roughtime key: %w", err)
+		}
+		edKey, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("roughtime key must be an Ed25519 key, got %T", key)
+		}
+		return edKey, nil
+	}
+
+	tsaSigner, _, err := newSigner(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := tsaSigner.Public().(ed25519.PublicKey); ok {
+		return tsaSigner, nil
+	}
+	if cfg.Signer.Type != signer.MemoryScheme {
+		return nil, errors.New("roughtime requires an Ed25519 key: set roughtime.key_path, or use an Ed25519 timestamp signer")
+	}
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger.Warn("using an in-memory roughtime key, which should only be used for testing")
+	return key, nil
+}
+
 func init() {
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(version.Version())
@@ -0,0 +1,175 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sigstore/timestamp-authority/pkg/audit"
+	"github.com/sigstore/timestamp-authority/pkg/config"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/sigstore/timestamp-authority/pkg/ntpmonitor"
+	"github.com/sigstore/timestamp-authority/pkg/spool"
+)
+
+var spoolCmd = &cobra.Command{
+	Use:   "spool --in <dir> --out <dir>",
+	Short: "timestamp requests exchanged as files",
+	Long: `Watches the input directory for DER-encoded timestamp requests with the .tsq extension, for
+air-gapped deployments where requests and responses are carried on removable media. Each request is
+issued with the configured signer, certificate chain and policies, like a request to the API, and is
+answered with a .tsr file of the same name in the output directory. Rejected requests are moved to the
+error directory, next to a .reason file explaining why. Every request is recorded in the audit log, if
+configured.
+
+A request is only processed once it has not changed between two scans, so that files still being
+copied are left alone. With --once, every request present is processed at once and the command exits.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return err
+		}
+		log.ConfigureLogger(viper.GetString("log-type"))
+
+		cfg, err := loadConfig(viper.GetString("server-config"))
+		if err != nil {
+			for _, e := range config.Errors(err) {
+				log.Logger.Error(e)
+			}
+			return errors.New("invalid server configuration")
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+
+		var observers []issuer.Observer
+		var tsaIssuer *issuer.Issuer
+		var auditLog *audit.Log
+		if cfg.Audit.Path != "" {
+			auditLog, err = audit.Open(cfg.Audit.Path)
+			if err != nil {
+				return fmt.Errorf("opening audit log: %w", err)
+			}
+			defer func() {
+				if err := auditLog.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+			auditLog.OnWriteFailure(auditWriteFailed(cfg, func() *issuer.Issuer { return tsaIssuer }))
+			auditLog.SetFailClosed(cfg.Audit.FailClosed)
+			observers = append(observers, auditLog)
+		}
+		links, err := openLinks(cfg)
+		if err != nil {
+			return err
+		}
+		if links != nil {
+			defer func() {
+				if err := links.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+		}
+
+		var ntpm *ntpmonitor.NTPMonitor
+		var clockOffset issuer.ClockOffset
+		if !cfg.NTP.Disabled {
+			ntpm, err = ntpmonitor.New(cfg.NTP.ConfigPath)
+			if err != nil {
+				return fmt.Errorf("initializing ntp monitor: %w", err)
+			}
+			clockOffset = ntpm
+		}
+
+		tsaIssuer, err = newIssuer(ctx, cfg, clockOffset, links, observers...)
+		if err != nil {
+			return fmt.Errorf("creating timestamp issuer: %w", err)
+		}
+
+		in, _ := cmd.Flags().GetString("in")
+		out, _ := cmd.Flags().GetString("out")
+		errDir, _ := cmd.Flags().GetString("errors")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		spooler, err := spool.New(tsaIssuer, spool.Options{In: in, Out: out, Errors: errDir, Interval: interval})
+		if err != nil {
+			return err
+		}
+
+		if auditLog != nil {
+			// runs after the background checkpoints stop
+			defer func() {
+				if err := auditLog.Checkpoint(context.Background(), audit.IssuerStamper(tsaIssuer)); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+		}
+
+		if once, _ := cmd.Flags().GetBool("once"); once {
+			n, err := spooler.Flush(ctx)
+			log.Logger.Infof("processed %d request(s)", n)
+			return err
+		}
+
+		// background tasks stop when the spooler does
+		bgCtx, cancel := context.WithCancel(ctx)
+		var background sync.WaitGroup
+		defer func() {
+			cancel()
+			background.Wait()
+		}()
+		if ntpm != nil {
+			background.Add(1)
+			go func() {
+				defer background.Done()
+				ntpm.Run(bgCtx)
+			}()
+		}
+		if auditLog != nil {
+			background.Add(1)
+			go func() {
+				defer background.Done()
+				auditLog.Run(bgCtx, cfg.Audit.CheckpointInterval, audit.IssuerStamper(tsaIssuer))
+			}()
+		}
+
+		log.Logger.Infof("spooling requests from %s to %s", in, out)
+		spooler.Run(ctx)
+		log.Logger.Info("spool stopped")
+		return nil
+	},
+}
+
+func init() {
+	spoolCmd.Flags().String("in", "", "Directory scanned for .tsq timestamp requests")
+	spoolCmd.Flags().String("out", "", "Directory .tsr timestamp responses are written to")
+	spoolCmd.Flags().String("errors", "", "Directory rejected requests are moved to. Defaults to the errors directory inside --out")
+	spoolCmd.Flags().Duration("interval", spool.DefaultInterval, "How often the input directory is scanned")
+	spoolCmd.Flags().Bool("once", false, "Process every request in the input directory once and exit")
+	_ = spoolCmd.MarkFlagRequired("in")
+	_ = spoolCmd.MarkFlagRequired("out")
+	rootCmd.AddCommand(spoolCmd)
+}
@@ -16,93 +16,135 @@
 package api
 
 import (
-	"bytes"
-	"context"
-	"crypto"
-	"crypto/x509"
 	"fmt"
-	"os"
-	"path/filepath"
+	"slices"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
-	"github.com/spf13/viper"
 
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/linking"
 	"github.com/sigstore/timestamp-authority/pkg/log"
-	"github.com/sigstore/timestamp-authority/pkg/signer"
-	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
 )
 
+// API serves the REST API on top of an issuer.
 type API struct {
-	tsaSigner     crypto.Signer       // the signer to use for timestamping
-	tsaSignerHash crypto.Hash         // hash algorithm used to hash pre-signed timestamps
-	certChain     []*x509.Certificate // timestamping cert chain
-	certChainPem  string              // PEM encoded timestamping cert chain
+	issuer          *issuer.Issuer           // issues timestamps
+	certChainPem    string                   // PEM encoded timestamping cert chain
+	certChainPKCS7  []byte                   // certs-only PKCS#7 encoded timestamping cert chain
+	certChainJSON   *models.CertificateChain // description of each certificate in the chain
+	info            *models.TimestampInfo
+	uri             string              // URI identifying the timestamp authority in the trusted root
+	trustedRoot     []trustedroot.Chain // historical and current timestamping cert chains
+	artifactMaxSize int64               // maximum size of hashed artifacts, disabled when 0
+	links           *linking.Chain      // published chain of linked timestamps, disabled when nil
+	lifecycle       *lifecycle          // drain and shutdown state, shared with the APIs it replaces
 }
 
-func NewAPI() (*API, error) {
-	ctx := context.Background()
+func NewAPI(i *issuer.Issuer, opts ...Option) (*API, error) {
+	if i == nil {
+		return nil, errors.New("issuer must be provided")
+	}
 
-	tsaSignerHash, err := signer.HashToAlg(viper.GetString("timestamp-signer-hash"))
+	certChainPEM, err := cryptoutils.MarshalCertificatesToPEM(i.CertChain())
 	if err != nil {
-		return nil, errors.Wrap(err, "error getting hash")
+		return nil, fmt.Errorf("marshal certificates to PEM: %w", err)
 	}
-	tsaSigner, err := signer.NewCryptoSigner(ctx, tsaSignerHash,
-		viper.GetString("timestamp-signer"),
-		viper.GetString("kms-key-resource"),
-		viper.GetString("tink-key-resource"), viper.GetString("tink-keyset-path"),
-		viper.GetString("tink-hcvault-token"),
-		viper.GetString("file-signer-key-path"), viper.GetString("file-signer-passwd"))
+
+	certChainPKCS7, err := certsOnlyPKCS7(i.CertChain())
 	if err != nil {
-		return nil, errors.Wrap(err, "getting new tsa signer")
+		return nil, fmt.Errorf("marshal certificates to PKCS#7: %w", err)
 	}
 
-	var certChain []*x509.Certificate
-
-	// KMS, Tink and File signers require a provided certificate chain
-	if viper.GetString("timestamp-signer") != signer.MemoryScheme {
-		certChainPath := viper.GetString("certificate-chain-path")
-		data, err := os.ReadFile(filepath.Clean(certChainPath))
-		if err != nil {
-			return nil, err
-		}
-		certChain, err = cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(data))
-		if err != nil {
-			return nil, err
-		}
-		if err := tsx509.VerifyCertChain(certChain, tsaSigner); err != nil {
-			return nil, err
-		}
-	} else {
-		// Generate an in-memory TSA certificate chain
-		certChain, err = signer.NewTimestampingCertWithChain(tsaSigner)
-		if err != nil {
-			return nil, errors.Wrap(err, "generating timestamping cert chain")
-		}
-	}
+	info := newTimestampInfo(i)
 
-	certChainPEM, err := cryptoutils.MarshalCertificatesToPEM(certChain)
-	if err != nil {
-		return nil, fmt.Errorf("marshal certificates to PEM: %w", err)
+	o := makeOptions(opts...)
+	chains := append(slices.Clone(o.HistoricalChains), trustedroot.Chain{Certificates: i.CertChain()})
+	if _, err := trustedroot.New(o.URI, chains); err != nil {
+		return nil, fmt.Errorf("trusted root: %w", err)
 	}
 
+	MetricCertificateExpiry.SetCertificates(i.CertChain())
+
 	return &API{
-		tsaSigner:     tsaSigner,
-		tsaSignerHash: tsaSignerHash,
-		certChain:     certChain,
-		certChainPem:  string(certChainPEM),
+		issuer:          i,
+		certChainPem:    string(certChainPEM),
+		certChainPKCS7:  certChainPKCS7,
+		certChainJSON:   &models.CertificateChain{Certificates: info.Certificates},
+		info:            info,
+		uri:             o.URI,
+		trustedRoot:     chains,
+		artifactMaxSize: o.ArtifactMaxSize,
+		links:           o.Links,
+		lifecycle:       &lifecycle{},
 	}, nil
 }
 
-var (
-	api *API
-)
+var current atomic.Pointer[API]
 
-func ConfigureAPI() {
-	var err error
+// errNotConfigured is returned until ConfigureAPI has been called.
+var errNotConfigured = errors.New("api is not configured")
 
-	api, err = NewAPI()
+// ConfigureAPI configures the API with a new issuer, starting from a server
+// that is neither drained nor shutting down.
+func ConfigureAPI(i *issuer.Issuer, opts ...Option) {
+	a, err := NewAPI(i, opts...)
 	if err != nil {
 		log.Logger.Panic(err)
 	}
+	current.Store(a)
+}
+
+// Reconfigure replaces the issuer serving the API. Requests already being
+// handled complete with the previous issuer, and the API stays drained or
+// shutting down if it was.
+func Reconfigure(i *issuer.Issuer, opts ...Option) error {
+	a, err := NewAPI(i, opts...)
+	if err != nil {
+		return err
+	}
+	if prev := current.Load(); prev != nil {
+		a.lifecycle = prev.lifecycle
+	}
+	current.Store(a)
+	return nil
+}
+
+// Issuer returns the issuer currently serving the API, or nil if the API is
+// not configured.
+func Issuer() *issuer.Issuer {
+	if a := current.Load(); a != nil {
+		return a.issuer
+	}
+	return nil
+}
+
+// Ready returns an error if the API should not receive traffic, either because
+// it is not configured, because the server is shutting down or issuance is
+// drained, or because a certificate in the chain is about to expire and
+// readiness has been configured to fail.
+func Ready() error {
+	api := current.Load()
+	if api == nil {
+		return errNotConfigured
+	}
+	if api.lifecycle.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
+	if api.lifecycle.draining.Load() {
+		return ErrDraining
+	}
+	return api.issuer.Ready()
+}
+
+// certificateExpiryWarning returns 1 while the issuer serving the API is
+// within its certificate expiry warning window, and 0 otherwise.
+func certificateExpiryWarning() float64 {
+	if api := current.Load(); api != nil && api.issuer.CheckCertificateExpiry() != nil {
+		return 1
+	}
+	return 0
 }
@@ -0,0 +1,106 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/digitorus/timestamp"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+)
+
+const (
+	artifactHashingDisabled = "Artifact hashing is not enabled"
+	artifactTooLarge        = "Artifact exceeds the maximum size"
+	failedToReadArtifact    = "Error reading artifact"
+	invalidPolicyOID        = "Invalid TSA policy OID"
+)
+
+// TimestampResponseForArtifactHandler hashes the uploaded artifact as it is
+// streamed, without retaining it, and timestamps its digest.
+func TimestampResponseForArtifactHandler(params ts.GetTimestampResponseForArtifactParams) middleware.Responder {
+	api := current.Load()
+	if api == nil {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, errNotConfigured, "")
+	}
+	if !api.startIssuance() {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, ErrDraining, "")
+	}
+	defer api.endIssuance()
+	if api.artifactMaxSize <= 0 {
+		return handleTimestampAPIError(params, http.StatusNotImplemented, errors.New("artifact hashing is disabled"), artifactHashingDisabled)
+	}
+
+	algName := strings.ToLower(swag.StringValue(params.HashAlgorithm))
+	hashAlg, errMsg, err := getHashAlg(algName)
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, errMsg)
+	}
+	var policy asn1.ObjectIdentifier
+	if oid := swag.StringValue(params.TsaPolicyOID); oid != "" {
+		if policy, err = tsx509.ParseOID(oid); err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidPolicyOID)
+		}
+	}
+
+	ctx := issuer.RequestContext(params.HTTPRequest)
+	_, span := tracing.Tracer().Start(ctx, "timestamp.hash_artifact",
+		trace.WithAttributes(attribute.String("tsa.hash_algorithm", algName)))
+	h := hashAlg.New()
+	n, err := io.Copy(h, io.LimitReader(params.Artifact, api.artifactMaxSize+1))
+	if err == nil && n > api.artifactMaxSize {
+		err = fmt.Errorf("artifact exceeds %d bytes", api.artifactMaxSize)
+	}
+	span.SetAttributes(attribute.Int64("tsa.artifact.size", n))
+	tracing.End(span, err)
+	if n > api.artifactMaxSize {
+		api.issuer.Reject(ctx, timestamp.BadRequest, artifactTooLarge, err)
+		return handleTimestampAPIError(params, http.StatusRequestEntityTooLarge, err, artifactTooLarge)
+	}
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, failedToReadArtifact)
+	}
+	digest := h.Sum(nil)
+
+	resp, err := api.issuer.Issue(ctx, &timestamp.Request{
+		HashAlgorithm: hashAlg,
+		HashedMessage: digest,
+		Certificates:  swag.BoolValue(params.Certificates),
+		TSAPolicyOID:  policy,
+	})
+	if err != nil {
+		return handleIssuanceError(params, err)
+	}
+
+	return ts.NewGetTimestampResponseForArtifactCreated().
+		WithXArtifactDigest(algName + ":" + hex.EncodeToString(digest)).
+		WithPayload(io.NopCloser(bytes.NewReader(resp)))
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/digitorus/timestamp"
+	"github.com/go-openapi/runtime/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+)
+
+const (
+	invalidAuthenticodeRequest = "Invalid Authenticode timestamp request"
+
+	// maxAuthenticodeRequestSize bounds the size of a base64 encoded
+	// Authenticode request, which only carries the signature to countersign.
+	maxAuthenticodeRequestSize = 1 << 16
+)
+
+// parseAuthenticodeRequest decodes a base64 encoded Authenticode request.
+// Line breaks, which some signing tools insert, are ignored.
+func parseAuthenticodeRequest(reqBytes []byte) (*issuer.AuthenticodeRequest, error) {
+	if len(reqBytes) > maxAuthenticodeRequestSize {
+		return nil, fmt.Errorf("request exceeds %d bytes", maxAuthenticodeRequestSize)
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(reqBytes)), ""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 encoded request: %w", err)
+	}
+	return issuer.ParseAuthenticodeRequest(der)
+}
+
+func AuthenticodeTimestampResponseHandler(params ts.GetAuthenticodeTimestampResponseParams) middleware.Responder {
+	api := current.Load()
+	if api == nil {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, errNotConfigured, "")
+	}
+	if !api.startIssuance() {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, ErrDraining, "")
+	}
+	defer api.endIssuance()
+
+	requestBytes, err := io.ReadAll(io.LimitReader(params.Request, maxAuthenticodeRequestSize+1))
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidAuthenticodeRequest)
+	}
+
+	ctx := issuer.RequestContext(params.HTTPRequest)
+	_, span := tracing.Tracer().Start(ctx, "timestamp.parse_request",
+		trace.WithAttributes(attribute.String("tsa.request.content_type", "authenticode")))
+	req, err := parseAuthenticodeRequest(requestBytes)
+	tracing.End(span, err)
+	if err != nil {
+		api.issuer.Reject(ctx, timestamp.BadDataFormat, invalidAuthenticodeRequest, err)
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidAuthenticodeRequest)
+	}
+
+	resp, err := api.issuer.IssueAuthenticode(ctx, req)
+	if err != nil {
+		return handleIssuanceError(params, err)
+	}
+
+	encoded := []byte(base64.StdEncoding.EncodeToString(resp))
+	return ts.NewGetAuthenticodeTimestampResponseOK().WithPayload(io.NopCloser(bytes.NewReader(encoded)))
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+)
+
+// Formats of the certificate chain, selected by the Accept header.
+const (
+	pemCertChainMediaType = "application/pem-certificate-chain"
+	pkcs7MediaType        = "application/pkcs7-mime"
+	pkixCertMediaType     = "application/pkix-cert"
+	jsonMediaType         = "application/json"
+)
+
+// certChainMediaTypes are offered in order of preference, so that PEM is
+// returned to clients accepting any format.
+var certChainMediaTypes = []string{pemCertChainMediaType, pkcs7MediaType, pkixCertMediaType, jsonMediaType}
+
+// certsOnlyPKCS7 encodes the chain as a certs-only PKCS#7 SignedData bundle.
+func certsOnlyPKCS7(certs []*x509.Certificate) ([]byte, error) {
+	var der []byte
+	for _, c := range certs {
+		der = append(der, c.Raw...)
+	}
+	return pkcs7.DegenerateCertificate(der)
+}
+
+func newCertificateInfo(c *x509.Certificate) *models.CertificateInfo {
+	fingerprint := sha256.Sum256(c.Raw)
+	notBefore := strfmt.DateTime(c.NotBefore.UTC())
+	notAfter := strfmt.DateTime(c.NotAfter.UTC())
+	return &models.CertificateInfo{
+		Subject:            swag.String(c.Subject.String()),
+		Issuer:             swag.String(c.Issuer.String()),
+		SerialNumber:       swag.String(c.SerialNumber.String()),
+		NotBefore:          &notBefore,
+		NotAfter:           &notAfter,
+		Sha256Fingerprint:  swag.String(hex.EncodeToString(fingerprint[:])),
+		PublicKeyAlgorithm: swag.String(publicKeyAlgorithm(c)),
+	}
+}
+
+// publicKeyAlgorithm describes the algorithm and size or curve of the
+// certificate's public key.
+func publicKeyAlgorithm(c *x509.Certificate) string {
+	switch pub := c.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("ECDSA %s", pub.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", pub.N.BitLen())
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return c.PublicKeyAlgorithm.String()
+	}
+}
+
+// rawResponder writes a 200 response with a body that is already encoded.
+func rawResponder(contentType string, body []byte) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", contentType)
+		rw.WriteHeader(http.StatusOK)
+		rw.Write(body) //nolint:errcheck
+	})
+}
+
+func GetTimestampCertChainHandler(params ts.GetTimestampCertChainParams) middleware.Responder {
+	api := current.Load()
+	switch middleware.NegotiateContentType(params.HTTPRequest, certChainMediaTypes, pemCertChainMediaType) {
+	case pkcs7MediaType:
+		return rawResponder(pkcs7MediaType+"; smime-type=certs-only", api.certChainPKCS7)
+	case pkixCertMediaType:
+		return rawResponder(pkixCertMediaType, api.issuer.CertChain()[0].Raw)
+	case jsonMediaType:
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+			rw.Header().Set("Content-Type", jsonMediaType)
+			rw.WriteHeader(http.StatusOK)
+			if err := producer.Produce(rw, api.certChainJSON); err != nil {
+				panic(err) // let the recovery middleware deal with this
+			}
+		})
+	default:
+		// the content type is set explicitly, as the generated responder leaves
+		// it to the runtime, which picks any of the produced types for */*
+		return rawResponder(pemCertChainMediaType, []byte(api.certChainPem))
+	}
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/sigstore/timestamp-authority/pkg/generated/models"
 	"github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
 	"github.com/sigstore/timestamp-authority/pkg/log"
 )
 
@@ -32,6 +34,28 @@ const (
 	WeakHashAlgorithmTimestampRequest = "Weak hash algorithm in timestamp request"
 )
 
+// issuanceError returns the message sent to the client when a timestamp
+// could not be issued, and whether the client is at fault. Only the message
+// of an *issuer.Error is safe to return.
+func issuanceError(err error) (string, bool) {
+	var ierr *issuer.Error
+	if errors.As(err, &ierr) {
+		return ierr.Message, ierr.IsClientError()
+	}
+	return failedToGenerateTimestampResponse, false
+}
+
+// handleIssuanceError answers a request whose timestamp could not be issued,
+// with 400 when the issuer rejected it and 500 otherwise.
+func handleIssuanceError(params interface{}, err error) middleware.Responder {
+	code := http.StatusInternalServerError
+	message, clientErr := issuanceError(err)
+	if clientErr {
+		code = http.StatusBadRequest
+	}
+	return handleTimestampAPIError(params, code, err, message)
+}
+
 func errorMsg(message string, code int) *models.Error {
 	return &models.Error{
 		Code:    int64(code),
@@ -67,6 +91,48 @@ func handleTimestampAPIError(params interface{}, code int, err error, message st
 		default:
 			return timestamp.NewGetTimestampResponseDefault(code).WithPayload(errorMsg(message, code))
 		}
+	case timestamp.GetTimestampResponseForArtifactParams:
+		logMsg(params.HTTPRequest)
+		switch code {
+		case http.StatusBadRequest:
+			return timestamp.NewGetTimestampResponseForArtifactBadRequest().WithPayload(errorMsg(message, code))
+		case http.StatusRequestEntityTooLarge:
+			return timestamp.NewGetTimestampResponseForArtifactRequestEntityTooLarge().WithPayload(errorMsg(message, code))
+		case http.StatusNotImplemented:
+			return timestamp.NewGetTimestampResponseForArtifactNotImplemented()
+		default:
+			return timestamp.NewGetTimestampResponseForArtifactDefault(code).WithPayload(errorMsg(message, code))
+		}
+	case timestamp.GetAuthenticodeTimestampResponseParams:
+		logMsg(params.HTTPRequest)
+		switch code {
+		case http.StatusBadRequest:
+			return timestamp.NewGetAuthenticodeTimestampResponseBadRequest().WithPayload(errorMsg(message, code))
+		default:
+			return timestamp.NewGetAuthenticodeTimestampResponseDefault(code).WithPayload(errorMsg(message, code))
+		}
+	case timestamp.VerifyTimestampResponseParams:
+		logMsg(params.HTTPRequest)
+		switch code {
+		case http.StatusBadRequest:
+			return timestamp.NewVerifyTimestampResponseBadRequest().WithPayload(errorMsg(message, code))
+		case http.StatusRequestEntityTooLarge:
+			return timestamp.NewVerifyTimestampResponseRequestEntityTooLarge().WithPayload(errorMsg(message, code))
+		case http.StatusNotImplemented:
+			return timestamp.NewVerifyTimestampResponseNotImplemented()
+		default:
+			return timestamp.NewVerifyTimestampResponseDefault(code).WithPayload(errorMsg(message, code))
+		}
+	case timestamp.GetTimestampLinksParams:
+		logMsg(params.HTTPRequest)
+		switch code {
+		case http.StatusBadRequest:
+			return timestamp.NewGetTimestampLinksBadRequest().WithPayload(errorMsg(message, code))
+		case http.StatusNotImplemented:
+			return timestamp.NewGetTimestampLinksNotImplemented()
+		default:
+			return timestamp.NewGetTimestampLinksDefault(code).WithPayload(errorMsg(message, code))
+		}
 	case timestamp.GetTimestampCertChainParams:
 		logMsg(params.HTTPRequest)
 		switch code {
@@ -75,6 +141,9 @@ func handleTimestampAPIError(params interface{}, code int, err error, message st
 		default:
 			return timestamp.NewGetTimestampCertChainDefault(code).WithPayload(errorMsg(message, code))
 		}
+	case timestamp.GetTimestampTrustedRootParams:
+		logMsg(params.HTTPRequest)
+		return timestamp.NewGetTimestampTrustedRootDefault(code).WithPayload(errorMsg(message, code))
 	default:
 		log.Logger.Errorf("unable to find method for type %T; error: %v", params, err)
 		return middleware.Error(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
@@ -0,0 +1,206 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/release-utils/version"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	"github.com/sigstore/timestamp-authority/pkg/generated/protobuf"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+// MaxBatchSize is the maximum number of requests in a BatchTimestamp call.
+const MaxBatchSize = 100
+
+// TimestampService implements the gRPC API on top of the issuer configured
+// with ConfigureAPI, so both APIs always serve the same signer.
+type TimestampService struct {
+	protobuf.UnimplementedTimestampServiceServer
+}
+
+func (TimestampService) Timestamp(ctx context.Context, req *protobuf.TimestampRequest) (*protobuf.TimestampResponse, error) {
+	api := current.Load()
+	if api == nil {
+		return nil, grpcError("Timestamp", codes.Unavailable, errNotConfigured, "")
+	}
+	if !api.startIssuance() {
+		return nil, grpcError("Timestamp", codes.Unavailable, ErrDraining, "")
+	}
+	defer api.endIssuance()
+
+	resp, err := api.timestampQuery(peerContext(ctx), "Timestamp", req.GetTimestampQuery())
+	if err != nil {
+		return nil, err
+	}
+	return &protobuf.TimestampResponse{TimestampReply: resp}, nil
+}
+
+func (TimestampService) BatchTimestamp(ctx context.Context, req *protobuf.BatchTimestampRequest) (*protobuf.BatchTimestampResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) == 0 || len(requests) > MaxBatchSize {
+		return nil, grpcError("BatchTimestamp", codes.InvalidArgument, fmt.Errorf("batch of %d requests", len(requests)),
+			fmt.Sprintf("A batch must hold between 1 and %d requests", MaxBatchSize))
+	}
+	api := current.Load()
+	if api == nil {
+		return nil, grpcError("BatchTimestamp", codes.Unavailable, errNotConfigured, "")
+	}
+	if !api.startIssuance() {
+		return nil, grpcError("BatchTimestamp", codes.Unavailable, ErrDraining, "")
+	}
+	defer api.endIssuance()
+
+	ctx = peerContext(ctx)
+	results := make([]*protobuf.BatchTimestampResult, 0, len(requests))
+	for _, r := range requests {
+		// there is no point in signing timestamps nobody will receive
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		resp, err := api.timestampQuery(ctx, "BatchTimestamp", r.GetTimestampQuery())
+		if err != nil {
+			results = append(results, &protobuf.BatchTimestampResult{
+				Result: &protobuf.BatchTimestampResult_Error{Error: status.Convert(err).Proto()},
+			})
+			continue
+		}
+		results = append(results, &protobuf.BatchTimestampResult{
+			Result: &protobuf.BatchTimestampResult_Response{Response: &protobuf.TimestampResponse{TimestampReply: resp}},
+		})
+	}
+	return &protobuf.BatchTimestampResponse{Results: results}, nil
+}
+
+func (TimestampService) GetCertChain(_ context.Context, _ *protobuf.GetCertChainRequest) (*protobuf.GetCertChainResponse, error) {
+	api := current.Load()
+	if api == nil {
+		return nil, grpcError("GetCertChain", codes.Unavailable, errNotConfigured, "")
+	}
+	chain := api.issuer.CertChain()
+	certificates := make([][]byte, 0, len(chain))
+	for _, c := range chain {
+		certificates = append(certificates, c.Raw)
+	}
+	return &protobuf.GetCertChainResponse{Certificates: certificates}, nil
+}
+
+func (TimestampService) GetInfo(_ context.Context, _ *protobuf.GetInfoRequest) (*protobuf.GetInfoResponse, error) {
+	api := current.Load()
+	if api == nil {
+		return nil, grpcError("GetInfo", codes.Unavailable, errNotConfigured, "")
+	}
+	i := api.issuer
+	acceptedPolicies := make([]string, 0, len(i.AcceptedPolicies()))
+	for _, p := range i.AcceptedPolicies() {
+		acceptedPolicies = append(acceptedPolicies, p.String())
+	}
+
+	certificates := make([]*protobuf.CertificateInfo, 0, len(i.CertChain()))
+	for _, c := range i.CertChain() {
+		certificates = append(certificates, newProtoCertificateInfo(c))
+	}
+
+	vi := version.GetVersionInfo()
+	return &protobuf.GetInfoResponse{
+		HashAlgorithms:       supportedHashAlgorithms,
+		DefaultPolicy:        i.DefaultPolicy().String(),
+		AcceptedPolicies:     acceptedPolicies,
+		Accuracy:             durationpb.New(i.Accuracy()),
+		CertificateInclusion: models.TimestampInfoCertificateInclusionLeafDashOnDashRequest,
+		Certificates:         certificates,
+		Version: &protobuf.VersionInfo{
+			GitVersion:   vi.GitVersion,
+			GitCommit:    vi.GitCommit,
+			GitTreeState: vi.GitTreeState,
+			BuildDate:    vi.BuildDate,
+			GoVersion:    vi.GoVersion,
+			Compiler:     vi.Compiler,
+			Platform:     vi.Platform,
+		},
+	}, nil
+}
+
+func newProtoCertificateInfo(c *x509.Certificate) *protobuf.CertificateInfo {
+	fingerprint := sha256.Sum256(c.Raw)
+	return &protobuf.CertificateInfo{
+		Subject:            c.Subject.String(),
+		Issuer:             c.Issuer.String(),
+		SerialNumber:       c.SerialNumber.String(),
+		NotBefore:          timestamppb.New(c.NotBefore),
+		NotAfter:           timestamppb.New(c.NotAfter),
+		Sha256Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		PublicKeyAlgorithm: publicKeyAlgorithm(c),
+	}
+}
+
+// timestampQuery issues a timestamp for a DER-encoded TimeStampReq, like
+// TimestampResponseHandler does, returning a gRPC status error on failure.
+func (api *API) timestampQuery(ctx context.Context, method string, query []byte) ([]byte, error) {
+	resp, err := api.issuer.IssueDER(ctx, query)
+	if err != nil {
+		code := codes.Internal
+		errMsg, clientErr := issuanceError(err)
+		if clientErr {
+			code = codes.InvalidArgument
+		}
+		return nil, grpcError(method, code, err, errMsg)
+	}
+	return resp, nil
+}
+
+// peerContext identifies the client of a gRPC call by the subject of its
+// verified TLS certificate, or by its address, like issuer.RequestContext.
+func peerContext(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	client := p.Addr.String()
+	if host, _, err := net.SplitHostPort(client); err == nil {
+		client = host
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if chains := tlsInfo.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+			client = chains[0][0].Subject.String()
+		}
+	}
+	return issuer.WithClient(ctx, client)
+}
+
+// grpcError logs err and returns the status sent to the client, which only
+// carries message so that internal details are not leaked.
+func grpcError(method string, code codes.Code, err error, message string) error {
+	if message == "" {
+		message = code.String()
+	}
+	log.Logger.Errorw("exiting with error", "method", method, "code", code.String(), "clientMessage", message, "error", err)
+	return status.Error(code, message)
+}
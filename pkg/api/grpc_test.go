@@ -0,0 +1,47 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/protobuf"
+)
+
+func TestGRPCNotConfigured(t *testing.T) {
+	prev := current.Load()
+	t.Cleanup(func() { current.Store(prev) })
+	current.Store(nil)
+
+	s := TimestampService{}
+	if _, err := s.GetCertChain(context.Background(), &protobuf.GetCertChainRequest{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected GetCertChain to be unavailable, got %v", err)
+	}
+	if _, err := s.GetInfo(context.Background(), &protobuf.GetInfoRequest{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected GetInfo to be unavailable, got %v", err)
+	}
+	if _, err := s.Timestamp(context.Background(), &protobuf.TimestampRequest{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Timestamp to be unavailable, got %v", err)
+	}
+	batch := &protobuf.BatchTimestampRequest{Requests: []*protobuf.TimestampRequest{{}}}
+	if _, err := s.BatchTimestamp(context.Background(), batch); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected BatchTimestamp to be unavailable, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"sigs.k8s.io/release-utils/version"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+)
+
+// supportedHashAlgorithms are the hash algorithms accepted in timestamp
+// requests, see getHashAlg.
+var supportedHashAlgorithms = []string{"sha256", "sha384", "sha512"}
+
+// supportedRequestContentTypes are the content types accepted for timestamp requests.
+var supportedRequestContentTypes = []string{"application/timestamp-query", "application/json"}
+
+// newTimestampInfo describes the capabilities of the issuer.
+func newTimestampInfo(i *issuer.Issuer) *models.TimestampInfo {
+	acceptedPolicies := make([]string, 0, len(i.AcceptedPolicies()))
+	for _, p := range i.AcceptedPolicies() {
+		acceptedPolicies = append(acceptedPolicies, p.String())
+	}
+
+	certificates := make([]*models.CertificateInfo, 0, len(i.CertChain()))
+	for _, c := range i.CertChain() {
+		certificates = append(certificates, newCertificateInfo(c))
+	}
+
+	vi := version.GetVersionInfo()
+	return &models.TimestampInfo{
+		HashAlgorithms:       supportedHashAlgorithms,
+		DefaultPolicy:        swag.String(i.DefaultPolicy().String()),
+		AcceptedPolicies:     acceptedPolicies,
+		Accuracy:             swag.String(i.Accuracy().String()),
+		RequestContentTypes:  supportedRequestContentTypes,
+		CertificateInclusion: swag.String(models.TimestampInfoCertificateInclusionLeafDashOnDashRequest),
+		Certificates:         certificates,
+		Version: &models.VersionInfo{
+			GitVersion:   vi.GitVersion,
+			GitCommit:    vi.GitCommit,
+			GitTreeState: vi.GitTreeState,
+			BuildDate:    vi.BuildDate,
+			GoVersion:    vi.GoVersion,
+			Compiler:     vi.Compiler,
+			Platform:     vi.Platform,
+		},
+	}
+}
+
+func GetTimestampInfoHandler(_ ts.GetTimestampInfoParams) middleware.Responder {
+	return ts.NewGetTimestampInfoOK().WithPayload(current.Load().info)
+}
@@ -0,0 +1,76 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+)
+
+const (
+	linkingDisabled   = "Linked timestamps are disabled"
+	invalidLinksRange = "Invalid range of link chain entries"
+	failedToReadLinks = "Error reading link chain entries"
+
+	// maxLinkEntries bounds the number of entries returned at once.
+	maxLinkEntries = 1000
+)
+
+// GetTimestampLinksHandler returns a page of the chain of linked timestamps,
+// along with its current length and head.
+func GetTimestampLinksHandler(params ts.GetTimestampLinksParams) middleware.Responder {
+	api := current.Load()
+	if api.links == nil {
+		return handleTimestampAPIError(params, http.StatusNotImplemented, errors.New("linking is disabled"), linkingDisabled)
+	}
+
+	length, head := api.links.Head()
+	start := swag.Int64Value(params.Start)
+	end := length
+	if params.End != nil {
+		end = min(*params.End, length)
+	}
+	if start > end {
+		return handleTimestampAPIError(params, http.StatusBadRequest, fmt.Errorf("start %d is after end %d", start, end), invalidLinksRange)
+	}
+	end = min(end, start+maxLinkEntries)
+
+	entries, err := api.links.Entries(start, end)
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusInternalServerError, err, failedToReadLinks)
+	}
+	chain := &models.LinkChain{
+		Length:  swag.Int64(length),
+		Head:    swag.String(hex.EncodeToString(head)),
+		Entries: make([]*models.LinkEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		chain.Entries = append(chain.Entries, &models.LinkEntry{
+			Index:     swag.Int64(e.Index),
+			TokenHash: swag.String(e.TokenHash),
+			Head:      swag.String(e.Head),
+		})
+	}
+	return ts.NewGetTimestampLinksOK().WithPayload(chain)
+}
@@ -16,11 +16,20 @@
 package api
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"sigs.k8s.io/release-utils/version"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
 )
 
 var (
@@ -63,6 +72,61 @@ var (
 		Help: "Total number of NTP related errors",
 	}, []string{"reason"})
 
+	MetricNTPOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "timestamp_authority_ntp_offset_seconds",
+		Help: "Last measured offset of the local clock from a NTP server, in seconds",
+	}, []string{"host"})
+
+	MetricNTPConsensusOffset = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "timestamp_authority_ntp_consensus_offset_seconds",
+		Help: "Median offset of the local clock from the NTP servers that responded in the last poll, in seconds",
+	})
+
+	MetricNTPLastSync = newNTPLastSyncCollector()
+
+	MetricTimestampsIssued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "timestamp_authority_timestamps_issued_total",
+		Help: "Total number of issued timestamps by policy, hash algorithm, and whether the certificate was requested",
+	}, []string{"policy", "hash_algorithm", "cert_req"})
+
+	MetricTimestampsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "timestamp_authority_timestamps_rejected_total",
+		Help: "Total number of rejected timestamp requests by RFC 3161 failure reason",
+	}, []string{"reason"})
+
+	MetricSigningLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "timestamp_authority_signing_latency",
+		Help: "Signing latency (in ns) by signer backend",
+		Buckets: prometheus.ExponentialBucketsRange(
+			float64(100*time.Microsecond),
+			float64(4*time.Second),
+			12),
+	}, []string{"backend"})
+
+	MetricSignatureVerificationFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "timestamp_authority_signature_verification_failures_total",
+		Help: "Total number of signed timestamp responses that failed verification before being returned",
+	})
+
+	MetricAuditWriteFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "timestamp_authority_audit_write_failures_total",
+		Help: "Total number of timestamp requests whose audit log record could not be written",
+	})
+
+	MetricRoughtimeRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "timestamp_authority_roughtime_requests_total",
+		Help: "Total number of Roughtime requests by outcome: responded, unsynced, unsupported_version, unknown_server or invalid",
+	}, []string{"outcome"})
+
+	// MetricCertificateExpiryWarning is evaluated when scraped, so that it
+	// does not depend on readiness being probed.
+	MetricCertificateExpiryWarning = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "timestamp_authority_certificate_expiry_warning",
+		Help: "Set to 1 while a certificate in the timestamping chain is within the expiry warning window",
+	}, certificateExpiryWarning)
+
+	MetricCertificateExpiry = newCertificateExpiryCollector()
+
 	_ = promauto.NewGaugeFunc(
 		prometheus.GaugeOpts{
 			Namespace: "timestamp_authority",
@@ -78,3 +142,118 @@ var (
 		func() float64 { return 1 },
 	)
 )
+
+func init() {
+	prometheus.MustRegister(MetricCertificateExpiry, MetricNTPLastSync)
+}
+
+// MetricsObserver counts issued and rejected timestamps and records the
+// signing latency of the issuer.
+var MetricsObserver = issuer.ObserverFunc(func(_ context.Context, e *issuer.Event) {
+	if e.SignDuration > 0 {
+		MetricSigningLatency.With(map[string]string{
+			"backend": e.SignerBackend,
+		}).Observe(float64(e.SignDuration))
+	}
+	if e.VerifyFailed {
+		MetricSignatureVerificationFailures.Inc()
+	}
+
+	if !e.Granted() {
+		reason := "unknown"
+		var ierr *issuer.Error
+		if errors.As(e.Err, &ierr) {
+			reason = ierr.Reason()
+		}
+		MetricTimestampsRejected.With(map[string]string{
+			"reason": reason,
+		}).Inc()
+		return
+	}
+	MetricTimestampsIssued.With(map[string]string{
+		"policy":         e.Policy.String(),
+		"hash_algorithm": e.HashAlgorithm(),
+		"cert_req":       strconv.FormatBool(e.Request.Certificates),
+	}).Inc()
+})
+
+// ntpLastSyncCollector reports the number of seconds since the local clock
+// was last found in sync with the NTP servers. Nothing is reported until the
+// first successful sync.
+type ntpLastSyncCollector struct {
+	lastSync atomic.Int64
+	desc     *prometheus.Desc
+}
+
+func newNTPLastSyncCollector() *ntpLastSyncCollector {
+	return &ntpLastSyncCollector{
+		desc: prometheus.NewDesc(
+			"timestamp_authority_ntp_seconds_since_last_sync",
+			"Seconds since enough NTP servers last agreed with the local clock",
+			nil, nil),
+	}
+}
+
+// SetLastSync records a successful sync at t.
+func (c *ntpLastSyncCollector) SetLastSync(t time.Time) {
+	c.lastSync.Store(t.UnixNano())
+}
+
+func (c *ntpLastSyncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *ntpLastSyncCollector) Collect(ch chan<- prometheus.Metric) {
+	lastSync := c.lastSync.Load()
+	if lastSync == 0 {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue,
+		time.Since(time.Unix(0, lastSync)).Seconds())
+}
+
+// certificateExpiryCollector reports the number of seconds until each
+// certificate in the timestamping chain expires. The value is computed
+// when the metric is scraped.
+type certificateExpiryCollector struct {
+	mu    sync.RWMutex
+	certs []*x509.Certificate
+	desc  *prometheus.Desc
+}
+
+func newCertificateExpiryCollector() *certificateExpiryCollector {
+	return &certificateExpiryCollector{
+		desc: prometheus.NewDesc(
+			"timestamp_authority_certificate_expiry_seconds",
+			"Seconds until a certificate in the timestamping chain expires",
+			[]string{"position", "subject", "serial"}, nil),
+	}
+}
+
+// SetCertificates replaces the certificate chain that is reported.
+func (c *certificateExpiryCollector) SetCertificates(certs []*x509.Certificate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.certs = certs
+}
+
+func (c *certificateExpiryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *certificateExpiryCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i, cert := range c.certs {
+		position := "intermediate"
+		switch i {
+		case 0:
+			position = "leaf"
+		case len(c.certs) - 1:
+			position = "root"
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue,
+			time.Until(cert.NotAfter).Seconds(),
+			position, cert.Subject.String(), hex.EncodeToString(cert.SerialNumber.Bytes()))
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
+)
+
+// Option is a functional option for customizing the API.
+type Option func(*options)
+
+type options struct {
+	URI              string
+	HistoricalChains []trustedroot.Chain
+	ArtifactMaxSize  int64
+	Links            *linking.Chain
+}
+
+func makeOptions(opts ...Option) *options {
+	o := &options{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithURI sets the URI identifying the timestamp authority in the trusted
+// root. Defaults to the timestamp endpoint of the host serving the request.
+func WithURI(uri string) Option {
+	return func(o *options) {
+		o.URI = uri
+	}
+}
+
+// WithHistoricalChains adds certificate chains previously used by the
+// timestamp authority to the trusted root.
+func WithHistoricalChains(chains []trustedroot.Chain) Option {
+	return func(o *options) {
+		o.HistoricalChains = chains
+	}
+}
+
+// WithArtifactHashing enables the endpoint hashing uploaded artifacts of up to
+// maxSize bytes. The endpoint is disabled by default.
+func WithArtifactHashing(maxSize int64) Option {
+	return func(o *options) {
+		o.ArtifactMaxSize = maxSize
+	}
+}
+
+// WithLinks publishes the chain of linked timestamps. The endpoint is disabled
+// by default.
+func WithLinks(chain *linking.Chain) Option {
+	return func(o *options) {
+		o.Links = chain
+	}
+}
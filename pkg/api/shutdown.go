@@ -0,0 +1,164 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+var (
+	// ErrDraining is returned for timestamp requests while issuance is drained.
+	ErrDraining = errors.New("timestamp issuance is drained")
+	// ErrShuttingDown is returned by Ready once the server starts shutting down.
+	ErrShuttingDown = errors.New("server is shutting down")
+)
+
+// lifecycle is the drain and shutdown state of an API. It is handed over
+// when the configuration is reloaded, so that a reload neither resumes a
+// drained server nor loses track of the requests in flight.
+type lifecycle struct {
+	draining     atomic.Bool
+	shuttingDown atomic.Bool
+
+	// issuance orders drain after the timestamp requests that started before
+	// it, so that no request is added to inflight once drained.
+	issuance sync.RWMutex
+	inflight sync.WaitGroup
+}
+
+var (
+	shutdownMu      sync.Mutex
+	readinessDelay  time.Duration
+	shutdownTimeout = 15 * time.Second
+)
+
+func (l *lifecycle) drain() {
+	l.issuance.Lock()
+	defer l.issuance.Unlock()
+	l.draining.Store(true)
+}
+
+// startIssuance registers an in-flight timestamp request, returning false
+// if issuance is drained. The request must call endIssuance when complete.
+func (l *lifecycle) startIssuance() bool {
+	l.issuance.RLock()
+	defer l.issuance.RUnlock()
+	if l.draining.Load() {
+		return false
+	}
+	l.inflight.Add(1)
+	return true
+}
+
+func (l *lifecycle) endIssuance() {
+	l.inflight.Done()
+}
+
+func (l *lifecycle) shutdown(ctx context.Context, readinessDelay time.Duration) error {
+	l.shuttingDown.Store(true)
+	log.Logger.Infof("readiness failed, draining issuance in %v", readinessDelay)
+	select {
+	case <-ctx.Done():
+	case <-time.After(readinessDelay):
+	}
+	l.drain()
+
+	done := make(chan struct{})
+	go func() {
+		l.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		log.Logger.Info("in-flight timestamp requests completed")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for in-flight timestamp requests: %w", ctx.Err())
+	}
+}
+
+// startIssuance registers an in-flight timestamp request with the API,
+// returning false if the API is not configured or issuance is drained.
+func (api *API) startIssuance() bool {
+	return api != nil && api.lifecycle.startIssuance()
+}
+
+func (api *API) endIssuance() {
+	api.lifecycle.endIssuance()
+}
+
+// Drain stops the API from issuing timestamps and fails readiness, so that
+// the server can be taken out of rotation without being stopped.
+func Drain() {
+	if api := current.Load(); api != nil {
+		api.lifecycle.drain()
+	}
+}
+
+// Resume undoes Drain.
+func Resume() {
+	if api := current.Load(); api != nil {
+		api.lifecycle.draining.Store(false)
+	}
+}
+
+// Draining reports whether issuance is drained.
+func Draining() bool {
+	api := current.Load()
+	return api != nil && api.lifecycle.draining.Load()
+}
+
+// Shutdown prepares the API for the server to stop. Readiness fails at once,
+// so that load balancers stop routing traffic to the server, and issuance is
+// drained after readinessDelay. Shutdown then waits for in-flight timestamp
+// requests to complete, returning an error if ctx is done first.
+func Shutdown(ctx context.Context, readinessDelay time.Duration) error {
+	api := current.Load()
+	if api == nil {
+		return nil
+	}
+	return api.lifecycle.shutdown(ctx, readinessDelay)
+}
+
+// ConfigureShutdown sets the readiness delay and grace period used by
+// PreServerShutdown.
+func ConfigureShutdown(delay, gracePeriod time.Duration) {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	readinessDelay, shutdownTimeout = delay, delay+gracePeriod
+}
+
+// PreServerShutdown runs Shutdown with the configured readiness delay, giving
+// in-flight timestamp requests the configured grace period to complete. It is
+// called by the REST API server before it stops its listeners.
+func PreServerShutdown() {
+	shutdownMu.Lock()
+	delay, timeout := readinessDelay, shutdownTimeout
+	shutdownMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := Shutdown(ctx, delay); err != nil {
+		log.Logger.Warn(err)
+	}
+}
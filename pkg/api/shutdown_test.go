@@ -0,0 +1,146 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+func TestShutdown(t *testing.T) {
+	l := &lifecycle{}
+
+	if !l.startIssuance() {
+		t.Fatal("expected issuance to be accepted")
+	}
+	done := make(chan error)
+	go func() {
+		done <- l.shutdown(context.Background(), 100*time.Millisecond)
+	}()
+
+	// readiness fails at once, but issuance is only refused after the delay
+	for !l.shuttingDown.Load() {
+		time.Sleep(time.Millisecond)
+	}
+	if l.draining.Load() {
+		t.Fatal("expected issuance not to be drained before the readiness delay")
+	}
+	for !l.draining.Load() {
+		time.Sleep(time.Millisecond)
+	}
+	if l.startIssuance() {
+		t.Fatal("expected issuance to be refused after the readiness delay")
+	}
+
+	select {
+	case err := <-done:
+		t.Fatalf("expected shutdown to wait for the in-flight request, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.endIssuance()
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error shutting down: %v", err)
+	}
+}
+
+func TestShutdownGracePeriod(t *testing.T) {
+	l := &lifecycle{}
+
+	if !l.startIssuance() {
+		t.Fatal("expected issuance to be accepted")
+	}
+	defer l.endIssuance()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.shutdown(ctx, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected grace period to expire, got %v", err)
+	}
+}
+
+func TestHandlersNotConfigured(t *testing.T) {
+	prev := current.Load()
+	t.Cleanup(func() { current.Store(prev) })
+	current.Store(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/timestamp", nil)
+	for name, responder := range map[string]middleware.Responder{
+		"timestamp":    TimestampResponseHandler(ts.GetTimestampResponseParams{HTTPRequest: req}),
+		"artifact":     TimestampResponseForArtifactHandler(ts.GetTimestampResponseForArtifactParams{HTTPRequest: req}),
+		"authenticode": AuthenticodeTimestampResponseHandler(ts.GetAuthenticodeTimestampResponseParams{HTTPRequest: req}),
+		"verify":       VerifyTimestampResponseHandler(ts.VerifyTimestampResponseParams{HTTPRequest: req}),
+	} {
+		rec := httptest.NewRecorder()
+		responder.WriteResponse(rec, runtime.JSONProducer())
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Fatalf("%s: expected 503, got %d", name, rec.Code)
+		}
+	}
+}
+
+func newTestIssuer(t *testing.T) *issuer.Issuer {
+	t.Helper()
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, leafKey, _ := testutils.GenerateLeafCert(subCert, subKey)
+	i, err := issuer.New(issuer.Options{Signer: leafKey, CertChain: []*x509.Certificate{leafCert, subCert, rootCert}})
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	return i
+}
+
+func TestLifecycleAcrossConfigurations(t *testing.T) {
+	prev := current.Load()
+	t.Cleanup(func() { current.Store(prev) })
+
+	ConfigureAPI(newTestIssuer(t))
+	Drain()
+	if err := Reconfigure(newTestIssuer(t)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Ready(); !errors.Is(err, ErrDraining) {
+		t.Fatalf("expected a reload to keep issuance drained, got %v", err)
+	}
+
+	if err := Shutdown(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := Ready(); !errors.Is(err, ErrShuttingDown) {
+		t.Fatalf("expected the server to be shutting down, got %v", err)
+	}
+
+	// a newly configured API starts afresh
+	ConfigureAPI(newTestIssuer(t))
+	if err := Ready(); err != nil {
+		t.Fatalf("expected a newly configured API to be ready, got %v", err)
+	}
+	if !current.Load().startIssuance() {
+		t.Fatal("expected a newly configured API to issue timestamps")
+	}
+	current.Load().endIssuance()
+}
@@ -16,8 +16,8 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"crypto"
-	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -26,12 +26,16 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/digitorus/timestamp"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
 	"github.com/sigstore/timestamp-authority/pkg/verification"
 )
 
@@ -102,20 +106,6 @@ func ParseJSONRequest(reqBytes []byte) (*timestamp.Request, string, error) {
 	return &tsReq, "", nil
 }
 
-func parseDERRequest(reqBytes []byte) (*timestamp.Request, string, error) {
-	parsed, err := timestamp.ParseRequest(reqBytes)
-	if err != nil {
-		return nil, failedToGenerateTimestampResponse, err
-	}
-
-	// verify that the request's hash algorithm is supported
-	if err := verification.VerifyRequest(parsed); err != nil {
-		return nil, WeakHashAlgorithmTimestampRequest, err
-	}
-
-	return parsed, "", nil
-}
-
 func getContentType(r *http.Request) (string, error) {
 	contentTypeHeader := r.Header.Get("Content-Type")
 	splitHeader := strings.Split(contentTypeHeader, "application/")
@@ -125,66 +115,67 @@ func getContentType(r *http.Request) (string, error) {
 	return splitHeader[1], nil
 }
 
-func requestBodyToTimestampReq(reqBytes []byte, contentType string) (*timestamp.Request, string, error) {
+// issueRequest issues a timestamp for a request body of the given content
+// type. A DER-encoded request goes through the issuance core shared with the
+// other transports, and failures are returned as an *issuer.Error.
+func (api *API) issueRequest(ctx context.Context, contentType string, body []byte) ([]byte, error) {
+	if contentType == "timestamp-query" {
+		return api.issuer.IssueDER(ctx, body)
+	}
+
+	_, span := tracing.Tracer().Start(ctx, "timestamp.parse_request",
+		trace.WithAttributes(attribute.String("tsa.request.content_type", contentType)))
+	var req *timestamp.Request
+	var errMsg string
+	var err error
 	switch contentType {
 	case "json":
-		return ParseJSONRequest(reqBytes)
-	case "timestamp-query":
-		return parseDERRequest(reqBytes)
+		req, errMsg, err = ParseJSONRequest(body)
 	default:
-		return nil, failedToGenerateTimestampResponse, fmt.Errorf("unsupported content type")
+		errMsg, err = failedToGenerateTimestampResponse, fmt.Errorf("unsupported content type")
 	}
+	tracing.End(span, err)
+	if err != nil {
+		fi := timestamp.BadDataFormat
+		if errMsg == WeakHashAlgorithmTimestampRequest {
+			fi = timestamp.BadAlgorithm
+		}
+		return nil, api.issuer.Reject(ctx, fi, errMsg, err)
+	}
+	return api.issuer.Issue(ctx, req)
 }
 
+const requestTooLarge = "Timestamp request is too large"
+
 func TimestampResponseHandler(params ts.GetTimestampResponseParams) middleware.Responder {
-	requestBytes, err := io.ReadAll(params.Request)
-	if err != nil {
-		return handleTimestampAPIError(params, http.StatusBadRequest, err, failedToGenerateTimestampResponse)
+	api := current.Load()
+	if api == nil {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, errNotConfigured, "")
 	}
-
-	contentType, err := getContentType(params.HTTPRequest)
-	if err != nil {
-		return handleTimestampAPIError(params, http.StatusUnsupportedMediaType, err, failedToGenerateTimestampResponse)
+	if !api.startIssuance() {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, ErrDraining, "")
 	}
+	defer api.endIssuance()
 
-	req, errMsg, err := requestBodyToTimestampReq(requestBytes, contentType)
+	requestBytes, err := io.ReadAll(io.LimitReader(params.Request, issuer.MaxRequestSize+1))
 	if err != nil {
-		return handleTimestampAPIError(params, http.StatusBadRequest, err, errMsg)
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, failedToGenerateTimestampResponse)
 	}
-
-	policyID := req.TSAPolicyOID
-	if policyID.String() == "" {
-		policyID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2}
+	if len(requestBytes) > issuer.MaxRequestSize {
+		err := fmt.Errorf("request exceeds %d bytes", issuer.MaxRequestSize)
+		api.issuer.Reject(issuer.RequestContext(params.HTTPRequest), timestamp.BadRequest, requestTooLarge, err)
+		return handleTimestampAPIError(params, http.StatusRequestEntityTooLarge, err, requestTooLarge)
 	}
 
-	duration, _ := time.ParseDuration("1s")
-
-	tsStruct := timestamp.Timestamp{
-		HashAlgorithm: req.HashAlgorithm,
-		HashedMessage: req.HashedMessage,
-		// The field here is going to be serialized as a GeneralizedTime, and RFC5280
-		// states that the GeneralizedTime values MUST be expressed in Greenwich Mean Time.
-		// However, go asn1/marshal will happily accept other formats. So we force it directly here.
-		// https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.5.2
-		Time:     time.Now().UTC(),
-		Nonce:    req.Nonce,
-		Policy:   policyID,
-		Ordering: false,
-		Accuracy: duration,
-		// Not qualified for the european directive
-		Qualified:         false,
-		AddTSACertificate: req.Certificates,
-		ExtraExtensions:   req.Extensions,
+	contentType, err := getContentType(params.HTTPRequest)
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusUnsupportedMediaType, err, failedToGenerateTimestampResponse)
 	}
 
-	resp, err := tsStruct.CreateResponseWithOpts(api.certChain[0], api.tsaSigner, api.tsaSignerHash)
+	resp, err := api.issueRequest(issuer.RequestContext(params.HTTPRequest), contentType, requestBytes)
 	if err != nil {
-		return handleTimestampAPIError(params, http.StatusInternalServerError, err, failedToGenerateTimestampResponse)
+		return handleIssuanceError(params, err)
 	}
 
 	return ts.NewGetTimestampResponseCreated().WithPayload(io.NopCloser(bytes.NewReader(resp)))
 }
-
-func GetTimestampCertChainHandler(_ ts.GetTimestampCertChainParams) middleware.Responder {
-	return ts.NewGetTimestampCertChainOK().WithPayload(api.certChainPem)
-}
@@ -0,0 +1,49 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
+)
+
+// timestampURI returns the URI of the timestamp endpoint for the host
+// serving the request.
+func timestampURI(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1/timestamp", scheme, r.Host)
+}
+
+func GetTimestampTrustedRootHandler(params ts.GetTimestampTrustedRootParams) middleware.Responder {
+	api := current.Load()
+	uri := api.uri
+	if uri == "" {
+		uri = timestampURI(params.HTTPRequest)
+	}
+	trustedRoot, err := trustedroot.Marshal(uri, api.trustedRoot)
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusInternalServerError, err, "Error generating trusted root")
+	}
+	return rawResponder(jsonMediaType, trustedRoot)
+}
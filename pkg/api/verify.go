@@ -0,0 +1,241 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/digitorus/timestamp"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	ts "github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+)
+
+const (
+	failedToReadTimestampResponse = "Error reading timestamp response"
+	invalidTimestampResponse      = "Invalid timestamp response"
+	invalidDigest                 = "Invalid digest, expected <algorithm>:<hex digest>"
+	invalidCertificateChain       = "Invalid certificate chain"
+	artifactOrDigestRequired      = "Either the artifact or its digest must be provided"
+
+	// maxTimestampResponseSize bounds the size of a timestamp response to
+	// verify, which carries at most a few certificates.
+	maxTimestampResponseSize = 1 << 20
+)
+
+// trustedChain is a certificate chain a timestamp response is verified
+// against, starting with the leaf certificate and ending with the root.
+type trustedChain struct {
+	name         string
+	certificates []*x509.Certificate
+}
+
+// VerifyTimestampResponseHandler verifies a timestamp response against each
+// certificate chain of the timestamp authority and the chain supplied by the
+// caller, and reports the outcome of each check.
+func VerifyTimestampResponseHandler(params ts.VerifyTimestampResponseParams) middleware.Responder {
+	api := current.Load()
+	if api == nil {
+		return handleTimestampAPIError(params, http.StatusServiceUnavailable, errNotConfigured, "")
+	}
+
+	tsr, err := io.ReadAll(io.LimitReader(params.Tsr, maxTimestampResponseSize+1))
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, failedToReadTimestampResponse)
+	}
+	if len(tsr) > maxTimestampResponseSize {
+		return handleTimestampAPIError(params, http.StatusRequestEntityTooLarge,
+			fmt.Errorf("timestamp response exceeds %d bytes", maxTimestampResponseSize), failedToReadTimestampResponse)
+	}
+	// a DER encoded response starts with a SEQUENCE tag, which is not a base64 character
+	if len(tsr) > 0 && tsr[0] != 0x30 {
+		if tsr, err = base64.StdEncoding.DecodeString(string(bytes.TrimSpace(tsr))); err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidTimestampResponse)
+		}
+	}
+	parsed, err := timestamp.ParseResponse(tsr)
+	if err != nil {
+		return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidTimestampResponse)
+	}
+	// an unknown message imprint algorithm is parsed as crypto.Hash(0)
+	if !parsed.HashAlgorithm.Available() {
+		return handleTimestampAPIError(params, http.StatusBadRequest,
+			fmt.Errorf("unsupported message imprint hash algorithm %v", parsed.HashAlgorithm), invalidTimestampResponse)
+	}
+
+	var imprint verification.Imprint
+	switch {
+	case params.Artifact != nil && params.Digest != nil:
+		return handleTimestampAPIError(params, http.StatusBadRequest, errors.New("both artifact and digest provided"), artifactOrDigestRequired)
+	case params.Artifact != nil:
+		if api.artifactMaxSize <= 0 {
+			return handleTimestampAPIError(params, http.StatusNotImplemented, errors.New("artifact hashing is disabled"), artifactHashingDisabled)
+		}
+		h := parsed.HashAlgorithm.New()
+		n, err := io.Copy(h, io.LimitReader(params.Artifact, api.artifactMaxSize+1))
+		if err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, failedToReadArtifact)
+		}
+		if n > api.artifactMaxSize {
+			return handleTimestampAPIError(params, http.StatusRequestEntityTooLarge,
+				fmt.Errorf("artifact exceeds %d bytes", api.artifactMaxSize), artifactTooLarge)
+		}
+		imprint = verification.Imprint{Hash: parsed.HashAlgorithm, Digest: h.Sum(nil)}
+	case params.Digest != nil:
+		algName, digestHex, _ := strings.Cut(swag.StringValue(params.Digest), ":")
+		hashAlg, _, err := getHashAlg(algName)
+		if err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidDigest)
+		}
+		digest, err := hex.DecodeString(digestHex)
+		if err != nil || len(digest) != hashAlg.Size() {
+			return handleTimestampAPIError(params, http.StatusBadRequest, fmt.Errorf("invalid %s digest %q", algName, digestHex), invalidDigest)
+		}
+		imprint = verification.Imprint{Hash: hashAlg, Digest: digest}
+	default:
+		return handleTimestampAPIError(params, http.StatusBadRequest, errors.New("no artifact or digest provided"), artifactOrDigestRequired)
+	}
+
+	var chains []trustedChain
+	if pemChain := swag.StringValue(params.CertificateChain); pemChain != "" {
+		certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(pemChain))
+		if err == nil && len(certs) == 0 {
+			err = errors.New("no certificates found")
+		}
+		if err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidCertificateChain)
+		}
+		chains = append(chains, trustedChain{name: models.VerificationReportTrustedChainSupplied, certificates: certs})
+	}
+	chains = append(chains, trustedChain{name: models.VerificationReportTrustedChainCurrent, certificates: api.issuer.CertChain()})
+	// the current chain is last in the trusted root
+	for _, c := range api.trustedRoot[:len(api.trustedRoot)-1] {
+		chains = append(chains, trustedChain{name: models.VerificationReportTrustedChainHistorical, certificates: c.Certificates})
+	}
+
+	// report the chain the response verifies against, or the one with the
+	// fewest failed checks
+	var best *models.VerificationReport
+	bestFailures := -1
+	for _, chain := range chains {
+		_, checks, err := verification.CheckTimestampResponse(tsr, imprint, verifyOptsForChain(chain.certificates))
+		if err != nil {
+			return handleTimestampAPIError(params, http.StatusBadRequest, err, invalidTimestampResponse)
+		}
+		report, failures := newVerificationReport(chain, checks)
+		if bestFailures < 0 || failures < bestFailures {
+			best, bestFailures = report, failures
+		}
+		if failures == 0 {
+			break
+		}
+	}
+	best.Timestamp = newTimestampToken(parsed)
+
+	return ts.NewVerifyTimestampResponseOK().WithPayload(best)
+}
+
+// LimitVerifyRequestSize bounds the size of verification requests before the
+// multipart form is parsed, leaving room for an artifact when artifact
+// hashing is enabled.
+func LimitVerifyRequestSize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := int64(2 * maxTimestampResponseSize)
+		if api := current.Load(); api != nil && api.artifactMaxSize > 0 {
+			limit += api.artifactMaxSize
+		}
+		if r.ContentLength > limit {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_ = json.NewEncoder(w).Encode(errorMsg(http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyOptsForChain verifies a timestamp response against a chain starting
+// with the leaf certificate and ending with the root. A chain of a single
+// certificate only provides the root.
+func verifyOptsForChain(certs []*x509.Certificate) verification.VerifyOpts {
+	opts := verification.VerifyOpts{Roots: certs[len(certs)-1:]}
+	if len(certs) > 1 {
+		opts.TSACertificate = certs[0]
+		opts.Intermediates = certs[1 : len(certs)-1]
+	}
+	return opts
+}
+
+func newVerificationReport(chain trustedChain, checks []verification.Check) (*models.VerificationReport, int) {
+	report := &models.VerificationReport{
+		TrustedChain: chain.name,
+		Certificates: make([]*models.CertificateInfo, 0, len(chain.certificates)),
+		Checks:       make([]*models.VerificationCheck, 0, len(checks)),
+	}
+	for _, c := range chain.certificates {
+		report.Certificates = append(report.Certificates, newCertificateInfo(c))
+	}
+	failures := 0
+	for _, c := range checks {
+		check := &models.VerificationCheck{Name: swag.String(c.Name), Passed: swag.Bool(c.Err == nil)}
+		if c.Err != nil {
+			check.Error = c.Err.Error()
+			failures++
+		}
+		report.Checks = append(report.Checks, check)
+	}
+	report.Verified = swag.Bool(failures == 0)
+	return report, failures
+}
+
+// newTimestampToken describes the fields of a parsed timestamp.
+func newTimestampToken(t *timestamp.Timestamp) *models.TimestampToken {
+	genTime := strfmt.DateTime(t.Time.UTC())
+	token := &models.TimestampToken{
+		GenTime:       &genTime,
+		Policy:        swag.String(t.Policy.String()),
+		SerialNumber:  swag.String(t.SerialNumber.String()),
+		HashAlgorithm: swag.String(strings.ToLower(strings.ReplaceAll(t.HashAlgorithm.String(), "-", ""))),
+		HashedMessage: swag.String(hex.EncodeToString(t.HashedMessage)),
+		Ordering:      t.Ordering,
+		Certificates:  make([]*models.CertificateInfo, 0, len(t.Certificates)),
+	}
+	if t.Accuracy != 0 {
+		token.Accuracy = t.Accuracy.String()
+	}
+	if t.Nonce != nil {
+		token.Nonce = t.Nonce.String()
+	}
+	for _, c := range t.Certificates {
+		token.Certificates = append(token.Certificates, newCertificateInfo(c))
+	}
+	return token
+}
@@ -0,0 +1,401 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit writes a tamper-evident audit log of timestamp requests.
+//
+// The log is an append-only file of JSON records, one per line. Each record
+// holds the SHA-256 hash of the previous line, so that editing or removing a
+// record breaks the chain. At intervals, a checkpoint record holding a
+// timestamp over the hash of the latest record is appended, and the position
+// of the checkpoint is saved alongside the log, so that removing records from
+// the end of the log can be detected.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+// Types of records.
+const (
+	TypeIssuance   = "issuance"
+	TypeCheckpoint = "checkpoint"
+)
+
+// Outcomes of issuance records.
+const (
+	OutcomeGranted  = "granted"
+	OutcomeRejected = "rejected"
+)
+
+// genesis is the previous hash of the first record.
+var genesis = make([]byte, sha256.Size)
+
+// Record is a line of the audit log.
+type Record struct {
+	// Seq is the position of the record in the log, starting at 1.
+	Seq uint64 `json:"seq"`
+	// Prev is the hex-encoded SHA-256 hash of the previous line.
+	Prev string    `json:"prev"`
+	Time time.Time `json:"time"`
+	Type string    `json:"type"`
+
+	// Fields of issuance records. LocalTime is the uncorrected time of the
+	// local clock, set when GenTime was corrected.
+	Outcome       string     `json:"outcome,omitempty"`
+	SerialNumber  string     `json:"serialNumber,omitempty"`
+	Imprint       string     `json:"imprint,omitempty"`
+	HashAlgorithm string     `json:"hashAlgorithm,omitempty"`
+	Policy        string     `json:"policy,omitempty"`
+	GenTime       *time.Time `json:"genTime,omitempty"`
+	LocalTime     *time.Time `json:"localTime,omitempty"`
+	Client        string     `json:"client,omitempty"`
+	RequestID     string     `json:"requestId,omitempty"`
+	FailureInfo   string     `json:"failureInfo,omitempty"`
+	Error         string     `json:"error,omitempty"`
+
+	// Fields of checkpoint records.
+	HeadSeq  uint64 `json:"headSeq,omitempty"`
+	HeadHash string `json:"headHash,omitempty"`
+	// Timestamp is a DER-encoded TimeStampResp over the raw bytes of HeadHash.
+	Timestamp []byte `json:"timestamp,omitempty"`
+}
+
+// head is the position of the latest checkpoint, saved alongside the log.
+type head struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// HeadPath returns the path of the file holding the latest checkpoint of the log at path.
+func HeadPath(path string) string {
+	return path + ".head"
+}
+
+// Stamper returns a DER-encoded TimeStampResp for a SHA-256 digest.
+type Stamper func(ctx context.Context, digest []byte) ([]byte, error)
+
+// IssuerStamper returns a Stamper timestamping with the issuer.
+func IssuerStamper(i *issuer.Issuer) Stamper {
+	return func(ctx context.Context, digest []byte) ([]byte, error) {
+		return i.Issue(ctx, &timestamp.Request{
+			HashAlgorithm: crypto.SHA256,
+			HashedMessage: digest,
+			Certificates:  true,
+		})
+	}
+}
+
+type checkpointKey struct{}
+
+// Log is an audit log, recording the outcome of every timestamp request as
+// an issuer.Observer.
+type Log struct {
+	mu             sync.Mutex
+	path           string
+	f              *os.File
+	seq            uint64
+	hash           []byte
+	lastCheckpoint uint64
+	onWriteFailure func(error)
+	failClosed     bool
+}
+
+// Open opens the audit log at path for appending, creating it if needed. It
+// fails if the last record of the log is incomplete. Records are not verified,
+// see Verify.
+func Open(path string) (*Log, error) {
+	l := &Log{path: path, hash: genesis}
+
+	last, err := lastLine(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading audit log %s: %w", path, err)
+	}
+	if len(last) > 0 {
+		var r Record
+		if err := json.Unmarshal(last, &r); err != nil {
+			return nil, fmt.Errorf("parsing last record of audit log %s: %w", path, err)
+		}
+		sum := sha256.Sum256(last)
+		l.seq, l.hash = r.Seq, sum[:]
+		if r.Type == TypeCheckpoint {
+			l.lastCheckpoint = r.Seq
+		}
+	}
+
+	l.f, err = os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// OnWriteFailure sets a function called whenever the record of a timestamp
+// request cannot be written, such as to count the failure or to trip the
+// issuer so that later requests are refused.
+func (l *Log) OnWriteFailure(f func(error)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onWriteFailure = f
+}
+
+// SetFailClosed makes the issuer withhold a timestamp whose record cannot be
+// written, failing the request with systemFailure, so that no timestamp is
+// issued unaudited. Otherwise the timestamp is still returned.
+func (l *Log) SetFailClosed(failClosed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.failClosed = failClosed
+}
+
+// append writes the record as the next line of the log. l.mu must be held.
+func (l *Log) append(r *Record) error {
+	r.Seq = l.seq + 1
+	r.Prev = hex.EncodeToString(l.hash)
+	r.Time = time.Now().UTC()
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if _, err := l.f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	sum := sha256.Sum256(line)
+	l.seq, l.hash = r.Seq, sum[:]
+	return nil
+}
+
+// ObserveIssuance records the outcome of a timestamp request, see
+// RecordIssuance.
+func (l *Log) ObserveIssuance(ctx context.Context, e *issuer.Event) {
+	_ = l.RecordIssuance(ctx, e)
+}
+
+// RecordIssuance records the outcome of a timestamp request. A failure to
+// write the record is returned when the log fails closed, so that the issuer
+// withholds the timestamp, see SetFailClosed. Requests made to timestamp a
+// checkpoint are recorded by the checkpoint itself.
+func (l *Log) RecordIssuance(ctx context.Context, e *issuer.Event) error {
+	if ctx.Value(checkpointKey{}) != nil {
+		return nil
+	}
+
+	r := &Record{
+		Type:      TypeIssuance,
+		Outcome:   OutcomeGranted,
+		Client:    e.Client,
+		RequestID: e.RequestID,
+	}
+	if e.Request != nil {
+		r.Imprint = hex.EncodeToString(e.Request.HashedMessage)
+		r.HashAlgorithm = e.HashAlgorithm()
+	}
+	if len(e.Policy) > 0 {
+		r.Policy = e.Policy.String()
+	}
+	if !e.GenTime.IsZero() {
+		genTime := e.GenTime
+		r.GenTime = &genTime
+	}
+	if !e.LocalTime.IsZero() && !e.LocalTime.Equal(e.GenTime) {
+		localTime := e.LocalTime
+		r.LocalTime = &localTime
+	}
+	if e.SerialNumber != nil {
+		r.SerialNumber = e.SerialNumber.String()
+	}
+	if !e.Granted() {
+		r.Outcome = OutcomeRejected
+		r.Error = e.Err.Error()
+		var ierr *issuer.Error
+		if errors.As(e.Err, &ierr) {
+			r.FailureInfo = ierr.FailureInfo.String()
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.append(r); err != nil {
+		err = fmt.Errorf("writing record to audit log %s: %w", l.path, err)
+		log.Logger.Errorf("AUDIT LOG FAILURE: %v", err)
+		if l.onWriteFailure != nil {
+			l.onWriteFailure(err)
+		}
+		if l.failClosed {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checkpoint timestamps the hash of the latest record and appends the
+// timestamp to the log as a checkpoint record. Nothing is written if no
+// record was added since the previous checkpoint.
+func (l *Log) Checkpoint(ctx context.Context, stamp Stamper) error {
+	l.mu.Lock()
+	headSeq, headHash, lastCheckpoint := l.seq, l.hash, l.lastCheckpoint
+	l.mu.Unlock()
+	if headSeq == lastCheckpoint {
+		return nil
+	}
+
+	digest := sha256.Sum256(headHash)
+	tsr, err := stamp(context.WithValue(ctx, checkpointKey{}, true), digest[:])
+	if err != nil {
+		return fmt.Errorf("timestamping audit log head: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.append(&Record{
+		Type:      TypeCheckpoint,
+		HeadSeq:   headSeq,
+		HeadHash:  hex.EncodeToString(headHash),
+		Timestamp: tsr,
+	}); err != nil {
+		return err
+	}
+	if err := l.f.Sync(); err != nil {
+		return err
+	}
+	l.lastCheckpoint = l.seq
+	log.Logger.Infof("audit log checkpoint %d at record %d with hash %x", l.seq, headSeq, headHash)
+	return writeHead(l.path, head{Seq: l.seq, Hash: hex.EncodeToString(l.hash)})
+}
+
+// writeHead atomically replaces the head file of the log at path.
+func writeHead(path string, h head) error {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	tmp := HeadPath(path) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, HeadPath(path))
+}
+
+// Run writes a checkpoint at every interval until the context is done.
+func (l *Log) Run(ctx context.Context, interval time.Duration, stamp Stamper) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.Checkpoint(ctx, stamp); err != nil {
+				log.Logger.Errorf("audit log checkpoint: %v", err)
+			}
+		}
+	}
+}
+
+// Close closes the log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// lastLineChunk is how much of the log lastLine reads at a time.
+const lastLineChunk = 4096
+
+// lastLine returns the last line of the log without its newline, reading
+// backwards from the end so that only the last record is loaded. It fails if
+// the last line is incomplete.
+func lastLine(path string) ([]byte, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	end := info.Size()
+	if end == 0 {
+		return nil, nil
+	}
+	b := make([]byte, 1)
+	if _, err := f.ReadAt(b, end-1); err != nil {
+		return nil, err
+	}
+	if b[0] != '\n' {
+		return nil, errors.New("the last record is incomplete, the log was truncated")
+	}
+	end--
+
+	var line []byte
+	for pos := end; pos > 0; {
+		n := min(lastLineChunk, pos)
+		pos -= n
+		chunk := make([]byte, n)
+		if _, err := f.ReadAt(chunk, pos); err != nil {
+			return nil, err
+		}
+		if i := bytes.LastIndexByte(chunk, '\n'); i >= 0 {
+			return append(chunk[i+1:], line...), nil
+		}
+		line = append(chunk, line...)
+	}
+	return line, nil
+}
+
+// readLines splits the log into lines, failing if the last line is incomplete.
+func readLines(path string) ([][]byte, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines [][]byte
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && line[len(line)-1] != '\n' {
+			return nil, fmt.Errorf("record %d is incomplete, the log was truncated", len(lines)+1)
+		}
+		if len(line) > 0 {
+			lines = append(lines, line[:len(line)-1])
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return lines, nil
+			}
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,375 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+)
+
+func newIssuer(t *testing.T, observers ...issuer.Observer) (*issuer.Issuer, []*x509.Certificate) {
+	t.Helper()
+	tsaSigner, err := signer.NewCryptoSigner(context.Background(), crypto.SHA256, signer.MemoryScheme, "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error creating signer: %v", err)
+	}
+	certChain, err := signer.NewTimestampingCertWithChain(tsaSigner)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate chain: %v", err)
+	}
+	i, err := issuer.New(issuer.Options{
+		Signer:           tsaSigner,
+		CertChain:        certChain,
+		AcceptedPolicies: []asn1.ObjectIdentifier{{1, 2, 3}},
+		Observers:        observers,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	return i, certChain
+}
+
+// writeLog issues timestamps recorded to a new audit log, with a checkpoint
+// after the first two requests, returning the path of the log.
+func writeLog(t *testing.T) (string, []*x509.Certificate) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error opening audit log: %v", err)
+	}
+	defer l.Close()
+	i, certChain := newIssuer(t, l)
+
+	digest := sha256.Sum256([]byte("artifact"))
+	ctx := issuer.WithClient(context.Background(), "client.example.com")
+	if _, err := i.Issue(ctx, &timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:]}); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	if _, err := i.Issue(ctx, &timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:], TSAPolicyOID: asn1.ObjectIdentifier{4, 5, 6}}); err == nil {
+		t.Fatal("expected rejection for unaccepted policy")
+	}
+	if err := l.Checkpoint(context.Background(), IssuerStamper(i)); err != nil {
+		t.Fatalf("unexpected error writing checkpoint: %v", err)
+	}
+	// nothing to checkpoint
+	if err := l.Checkpoint(context.Background(), IssuerStamper(i)); err != nil {
+		t.Fatalf("unexpected error writing checkpoint: %v", err)
+	}
+	i.Reject(ctx, timestamp.BadDataFormat, "Invalid timestamp request", os.ErrInvalid)
+	return path, certChain
+}
+
+func readRecords(t *testing.T, path string) []Record {
+	t.Helper()
+	lines, err := readLines(path)
+	if err != nil {
+		t.Fatalf("unexpected error reading audit log: %v", err)
+	}
+	records := make([]Record, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal(line, &records[i]); err != nil {
+			t.Fatalf("unexpected error parsing record %d: %v", i+1, err)
+		}
+	}
+	return records
+}
+
+func TestLog(t *testing.T) {
+	path, certChain := writeLog(t)
+
+	records := readRecords(t, path)
+	if len(records) != 4 {
+		t.Fatalf("expected 4 records, got %d", len(records))
+	}
+
+	granted := records[0]
+	if granted.Type != TypeIssuance || granted.Outcome != OutcomeGranted {
+		t.Fatalf("expected granted issuance, got %+v", granted)
+	}
+	if granted.SerialNumber == "" || granted.GenTime == nil || granted.LocalTime != nil || granted.Policy != issuer.DefaultPolicy.String() {
+		t.Fatalf("expected serial number, generation time and policy, got %+v", granted)
+	}
+	if granted.HashAlgorithm != "sha256" || granted.Client != "client.example.com" {
+		t.Fatalf("unexpected hash algorithm or client, got %+v", granted)
+	}
+
+	rejected := records[1]
+	if rejected.Outcome != OutcomeRejected || rejected.FailureInfo != timestamp.UnacceptedPolicy.String() || rejected.SerialNumber != "" {
+		t.Fatalf("expected rejection for unaccepted policy, got %+v", rejected)
+	}
+
+	checkpoint := records[2]
+	if checkpoint.Type != TypeCheckpoint || checkpoint.HeadSeq != 2 || len(checkpoint.Timestamp) == 0 {
+		t.Fatalf("expected checkpoint of record 2, got %+v", checkpoint)
+	}
+
+	unparsable := records[3]
+	if unparsable.Outcome != OutcomeRejected || unparsable.FailureInfo != timestamp.BadDataFormat.String() || unparsable.Imprint != "" {
+		t.Fatalf("expected rejection of unparsable request, got %+v", unparsable)
+	}
+
+	res, err := Verify(path, VerifyOpts{CertChain: certChain})
+	if err != nil {
+		t.Fatalf("unexpected error verifying audit log: %v", err)
+	}
+	if res.Records != 4 || res.Checkpoints != 1 || res.Unanchored != 1 || res.LastCheckpoint.IsZero() || res.HeadMissing {
+		t.Fatalf("unexpected verification result %+v", res)
+	}
+}
+
+func TestReopen(t *testing.T) {
+	path, _ := writeLog(t)
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error reopening audit log: %v", err)
+	}
+	i, _ := newIssuer(t, l)
+	digest := sha256.Sum256([]byte("artifact"))
+	if _, err := i.Issue(context.Background(), &timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:]}); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	l.Close()
+
+	res, err := Verify(path, VerifyOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error verifying reopened audit log: %v", err)
+	}
+	if res.Records != 5 {
+		t.Fatalf("expected 5 records, got %d", res.Records)
+	}
+}
+
+func TestWriteFailure(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, _ := newIssuer(t, l)
+	var failures []error
+	l.OnWriteFailure(func(err error) {
+		failures = append(failures, err)
+		i.Trip(err)
+	})
+	// the log can no longer be written
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("artifact"))
+	req := &timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:]}
+	if _, err := i.Issue(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	if len(failures) != 1 {
+		t.Fatalf("expected the write failure to be reported, got %v", failures)
+	}
+	// the tripped issuer refuses further requests
+	var ierr *issuer.Error
+	if _, err := i.Issue(context.Background(), req); !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure {
+		t.Fatalf("expected system failure once tripped, got %v", err)
+	}
+	if err := i.Ready(); err == nil {
+		t.Fatal("expected readiness error once tripped")
+	}
+}
+
+func TestWriteFailureFailClosed(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []*issuer.Event
+	observer := issuer.ObserverFunc(func(_ context.Context, e *issuer.Event) {
+		events = append(events, e)
+	})
+	i, _ := newIssuer(t, observer, l)
+	l.SetFailClosed(true)
+	// the log can no longer be written
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the timestamp whose record cannot be written is withheld
+	digest := sha256.Sum256([]byte("artifact"))
+	resp, err := i.Issue(context.Background(), &timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:]})
+	var ierr *issuer.Error
+	if resp != nil || !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure {
+		t.Fatalf("expected the timestamp to be withheld with a system failure, got %d bytes, %v", len(resp), err)
+	}
+	// other observers see the request fail
+	if len(events) != 1 || events[0].Granted() {
+		t.Fatalf("expected observers to see the request fail, got %+v", events)
+	}
+}
+
+func TestLastLine(t *testing.T) {
+	dir := t.TempDir()
+	long := strings.Repeat("x", 3*lastLineChunk)
+	for _, tc := range []struct {
+		name, content, last string
+	}{
+		{"empty", "", ""},
+		{"single", "a\n", "a"},
+		{"several", "a\nb\nc\n", "c"},
+		{"spanning chunks", "a\n" + long + "\n", long},
+		{"long first", long + "\nb\n", "b"},
+	} {
+		path := filepath.Join(dir, tc.name)
+		if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		last, err := lastLine(path)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if string(last) != tc.last {
+			t.Fatalf("%s: expected last line of %d bytes, got %d", tc.name, len(tc.last), len(last))
+		}
+	}
+
+	path := filepath.Join(dir, "incomplete")
+	if err := os.WriteFile(path, []byte("a\nb"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path); err == nil || !strings.Contains(err.Error(), "incomplete") {
+		t.Fatalf("expected error for an incomplete last record, got %v", err)
+	}
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(lines [][]byte) [][]byte
+		errMsg string
+	}{
+		{
+			name: "edited record",
+			tamper: func(lines [][]byte) [][]byte {
+				lines[0] = bytes.Replace(lines[0], []byte("client.example.com"), []byte("other.example.com"), 1)
+				return lines
+			},
+			errMsg: "record 2: previous hash does not match",
+		},
+		{
+			name: "removed record",
+			tamper: func(lines [][]byte) [][]byte {
+				return append(lines[:1], lines[2:]...)
+			},
+			errMsg: "record 2: unexpected sequence number 3",
+		},
+		{
+			name: "truncated at checkpoint",
+			tamper: func(lines [][]byte) [][]byte {
+				return lines[:2]
+			},
+			errMsg: "log was truncated: checkpoint 3 is missing",
+		},
+		{
+			name: "incomplete record",
+			tamper: func(lines [][]byte) [][]byte {
+				last := len(lines) - 1
+				lines[last] = lines[last][:10]
+				return lines
+			},
+			errMsg: "record 4 is incomplete",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path, certChain := writeLog(t)
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := tc.tamper(bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n")))
+			data = append(bytes.Join(lines, []byte("\n")), '\n')
+			if tc.name == "incomplete record" {
+				data = data[:len(data)-1]
+			}
+			if err := os.WriteFile(path, data, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = Verify(path, VerifyOpts{CertChain: certChain})
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("expected error containing %q, got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestVerifyMissingHead(t *testing.T) {
+	// truncate writes the first n records of the log and removes its head file.
+	truncate := func(t *testing.T, n int) string {
+		t.Helper()
+		path, _ := writeLog(t)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := bytes.Split(data, []byte("\n"))
+		data = append(bytes.Join(lines[:n], []byte("\n")), '\n')
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Remove(HeadPath(path)); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	// the checkpoint is kept, so a head file must have been written
+	_, err := Verify(truncate(t, 3), VerifyOpts{})
+	if err == nil || !strings.Contains(err.Error(), "the log has 1 checkpoints but no head") {
+		t.Fatalf("expected missing head error, got %v", err)
+	}
+
+	// without a checkpoint the missing head is reported
+	res, err := Verify(truncate(t, 1), VerifyOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error verifying audit log: %v", err)
+	}
+	if res.Records != 1 || !res.HeadMissing {
+		t.Fatalf("expected the missing head to be reported, got %+v", res)
+	}
+}
+
+func TestVerifyDetectsForgedCheckpoint(t *testing.T) {
+	path, _ := writeLog(t)
+	// a chain that did not sign the checkpoint
+	_, otherChain := newIssuer(t)
+	_, err := Verify(path, VerifyOpts{CertChain: otherChain})
+	if err == nil || !strings.Contains(err.Error(), "verifying checkpoint timestamp") {
+		t.Fatalf("expected checkpoint verification error, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+)
+
+// VerifyOpts configures Verify.
+type VerifyOpts struct {
+	// CertChain verifies the timestamps of checkpoints, starting with the leaf
+	// certificate and ending with the root. Optional, when not set only the
+	// message imprint of the timestamps is checked.
+	CertChain []*x509.Certificate
+}
+
+// VerifyResult summarizes a verified audit log.
+type VerifyResult struct {
+	// Records is the number of records in the log.
+	Records uint64
+	// Checkpoints is the number of checkpoint records in the log.
+	Checkpoints int
+	// LastCheckpoint is the generation time of the timestamp of the last checkpoint.
+	LastCheckpoint time.Time
+	// Unanchored is the number of records after the last checkpoint, which
+	// could be removed without detection.
+	Unanchored uint64
+	// Head is the hex-encoded hash of the last record.
+	Head string
+	// HeadMissing is set when the log has records but no checkpoint and the
+	// head file is missing. This is expected until the first checkpoint is
+	// written, but records removed from the end of the log together with the
+	// head file cannot be detected.
+	HeadMissing bool
+}
+
+// Verify checks the hash chain of the audit log at path and the timestamp of
+// every checkpoint. It also checks that the log still holds the latest
+// checkpoint saved alongside it, detecting records removed from its end.
+func Verify(path string, opts VerifyOpts) (*VerifyResult, error) {
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &VerifyResult{}
+	hashes := make([][]byte, 0, len(lines))
+	prev := genesis
+	var lastCheckpoint uint64
+	for i, line := range lines {
+		seq := uint64(i) + 1
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("record %d: %w", seq, err)
+		}
+		if r.Seq != seq {
+			return nil, fmt.Errorf("record %d: unexpected sequence number %d, records were removed or reordered", seq, r.Seq)
+		}
+		if r.Prev != hex.EncodeToString(prev) {
+			return nil, fmt.Errorf("record %d: previous hash does not match, record %d was modified", seq, seq-1)
+		}
+
+		if r.Type == TypeCheckpoint {
+			genTime, err := verifyCheckpoint(&r, hashes, opts)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: %w", seq, err)
+			}
+			res.Checkpoints++
+			res.LastCheckpoint = genTime
+			lastCheckpoint = seq
+		}
+
+		sum := sha256.Sum256(line)
+		prev = sum[:]
+		hashes = append(hashes, prev)
+	}
+
+	headMissing, err := verifyHead(path, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if headMissing && res.Checkpoints > 0 {
+		return nil, fmt.Errorf("log was truncated or %s was removed: the log has %d checkpoints but no head", HeadPath(path), res.Checkpoints)
+	}
+
+	res.Records = uint64(len(lines))
+	res.Unanchored = res.Records - lastCheckpoint
+	res.Head = hex.EncodeToString(prev)
+	res.HeadMissing = headMissing && len(lines) > 0
+	return res, nil
+}
+
+// verifyCheckpoint checks that the checkpoint timestamps the hash of an
+// earlier record, returning the generation time of the timestamp.
+func verifyCheckpoint(r *Record, hashes [][]byte, opts VerifyOpts) (time.Time, error) {
+	if r.HeadSeq == 0 || r.HeadSeq > uint64(len(hashes)) {
+		return time.Time{}, fmt.Errorf("checkpoint refers to unknown record %d", r.HeadSeq)
+	}
+	headHash := hashes[r.HeadSeq-1]
+	if r.HeadHash != hex.EncodeToString(headHash) {
+		return time.Time{}, fmt.Errorf("checkpoint hash does not match record %d", r.HeadSeq)
+	}
+
+	if len(opts.CertChain) == 0 {
+		ts, err := timestamp.ParseResponse(r.Timestamp)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing checkpoint timestamp: %w", err)
+		}
+		digest := sha256.Sum256(headHash)
+		if !bytes.Equal(ts.HashedMessage, digest[:]) {
+			return time.Time{}, errors.New("checkpoint timestamp is not over the checkpoint hash")
+		}
+		return ts.Time, nil
+	}
+
+	ts, err := verification.VerifyTimestampResponse(r.Timestamp, bytes.NewReader(headHash), verification.VerifyOpts{
+		TSACertificate: opts.CertChain[0],
+		Intermediates:  opts.CertChain[1 : len(opts.CertChain)-1],
+		Roots:          opts.CertChain[len(opts.CertChain)-1:],
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("verifying checkpoint timestamp: %w", err)
+	}
+	return ts.Time, nil
+}
+
+// verifyHead checks that the log holds the latest checkpoint saved alongside
+// it, returning whether the head file is missing.
+func verifyHead(path string, hashes [][]byte) (bool, error) {
+	data, err := os.ReadFile(filepath.Clean(HeadPath(path)))
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var h head
+	if err := json.Unmarshal(data, &h); err != nil {
+		return false, fmt.Errorf("parsing %s: %w", HeadPath(path), err)
+	}
+	if h.Seq > uint64(len(hashes)) {
+		return false, fmt.Errorf("log was truncated: checkpoint %d is missing, the log has %d records", h.Seq, len(hashes))
+	}
+	if h.Seq > 0 && h.Hash != hex.EncodeToString(hashes[h.Seq-1]) {
+		return false, fmt.Errorf("checkpoint %d does not match %s, the log was modified", h.Seq, HeadPath(path))
+	}
+	return false, nil
+}
@@ -0,0 +1,48 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/protobuf"
+)
+
+// GRPCTimestampClient is a client of the gRPC API of a timestamp authority.
+// It must be closed once no longer used.
+type GRPCTimestampClient struct {
+	protobuf.TimestampServiceClient
+	conn *grpc.ClientConn
+}
+
+// GetGRPCTimestampClient creates a client of the gRPC API served at target,
+// such as tsa.example.com:3001. The dial options must set the transport
+// credentials, e.g. grpc.WithTransportCredentials(credentials.NewTLS(nil)).
+func GetGRPCTimestampClient(target string, opts ...grpc.DialOption) (*GRPCTimestampClient, error) {
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCTimestampClient{
+		TimestampServiceClient: protobuf.NewTimestampServiceClient(conn),
+		conn:                   conn,
+	}, nil
+}
+
+// Close closes the connection to the server.
+func (c *GRPCTimestampClient) Close() error {
+	return c.conn.Close()
+}
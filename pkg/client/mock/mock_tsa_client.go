@@ -19,13 +19,18 @@ import (
 	"crypto"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"time"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
 
 	"github.com/digitorus/timestamp"
@@ -33,7 +38,9 @@ import (
 	"github.com/sigstore/sigstore/pkg/signature"
 	"github.com/sigstore/timestamp-authority/pkg/generated/client"
 	ts "github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
 	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
 )
 
 // TSAClient creates RFC3161 timestamps and implements client.TimestampAuthority.
@@ -91,6 +98,46 @@ func (c *TSAClient) GetTimestampCertChain(_ *ts.GetTimestampCertChainParams, _ .
 	return &ts.GetTimestampCertChainOK{Payload: c.CertChainPEM}, nil
 }
 
+func (c *TSAClient) GetTimestampInfo(_ *ts.GetTimestampInfoParams, _ ...ts.ClientOption) (*ts.GetTimestampInfoOK, error) {
+	certificates := make([]*models.CertificateInfo, 0, len(c.CertChain))
+	for _, cert := range c.CertChain {
+		fingerprint := sha256.Sum256(cert.Raw)
+		notBefore := strfmt.DateTime(cert.NotBefore.UTC())
+		notAfter := strfmt.DateTime(cert.NotAfter.UTC())
+		certificates = append(certificates, &models.CertificateInfo{
+			Subject:            swag.String(cert.Subject.String()),
+			Issuer:             swag.String(cert.Issuer.String()),
+			SerialNumber:       swag.String(cert.SerialNumber.String()),
+			NotBefore:          &notBefore,
+			NotAfter:           &notAfter,
+			Sha256Fingerprint:  swag.String(hex.EncodeToString(fingerprint[:])),
+			PublicKeyAlgorithm: swag.String(cert.PublicKeyAlgorithm.String()),
+		})
+	}
+	return &ts.GetTimestampInfoOK{Payload: &models.TimestampInfo{
+		HashAlgorithms:       []string{"sha256", "sha384", "sha512"},
+		DefaultPolicy:        swag.String("1.3.6.1.4.1.57264.2"),
+		AcceptedPolicies:     []string{},
+		Accuracy:             swag.String("1s"),
+		RequestContentTypes:  []string{"application/timestamp-query", "application/json"},
+		CertificateInclusion: swag.String(models.TimestampInfoCertificateInclusionLeafDashOnDashRequest),
+		Certificates:         certificates,
+		Version:              &models.VersionInfo{},
+	}}, nil
+}
+
+func (c *TSAClient) GetTimestampTrustedRoot(_ *ts.GetTimestampTrustedRootParams, _ ...ts.ClientOption) (*ts.GetTimestampTrustedRootOK, error) {
+	trustedRoot, err := trustedroot.Marshal("", []trustedroot.Chain{{Certificates: c.CertChain}})
+	if err != nil {
+		return nil, err
+	}
+	var payload interface{}
+	if err := json.Unmarshal(trustedRoot, &payload); err != nil {
+		return nil, err
+	}
+	return &ts.GetTimestampTrustedRootOK{Payload: payload}, nil
+}
+
 func (c *TSAClient) GetTimestampResponse(params *ts.GetTimestampResponseParams, w io.Writer, _ ...ts.ClientOption) (*ts.GetTimestampResponseCreated, error) {
 	var hashAlg crypto.Hash
 	var hashedMessage []byte
@@ -152,6 +199,42 @@ func (c *TSAClient) GetTimestampResponse(params *ts.GetTimestampResponseParams,
 	return &ts.GetTimestampResponseCreated{Payload: bytes.NewBuffer(resp)}, nil
 }
 
+// GetTimestampResponseForArtifact timestamps the SHA-256 digest of the artifact.
+func (c *TSAClient) GetTimestampResponseForArtifact(params *ts.GetTimestampResponseForArtifactParams, w io.Writer, _ ...ts.ClientOption) (*ts.GetTimestampResponseForArtifactCreated, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, params.Artifact); err != nil {
+		return nil, err
+	}
+	digest := h.Sum(nil)
+	req, err := (&timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest, Certificates: true}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.GetTimestampResponse(&ts.GetTimestampResponseParams{Request: io.NopCloser(bytes.NewReader(req))}, w)
+	if err != nil {
+		return nil, err
+	}
+	return &ts.GetTimestampResponseForArtifactCreated{Payload: resp.Payload, XArtifactDigest: "sha256:" + hex.EncodeToString(digest)}, nil
+}
+
+// GetAuthenticodeTimestampResponse is not supported by the mock client, which
+// only creates RFC3161 timestamps.
+func (c *TSAClient) GetAuthenticodeTimestampResponse(_ *ts.GetAuthenticodeTimestampResponseParams, _ io.Writer, _ ...ts.ClientOption) (*ts.GetAuthenticodeTimestampResponseOK, error) {
+	return nil, errors.New("authenticode timestamps are not supported by the mock client")
+}
+
+// VerifyTimestampResponse is not supported by the mock client, which only
+// creates timestamps.
+func (c *TSAClient) VerifyTimestampResponse(_ *ts.VerifyTimestampResponseParams, _ ...ts.ClientOption) (*ts.VerifyTimestampResponseOK, error) {
+	return nil, errors.New("verifying timestamps is not supported by the mock client")
+}
+
+// GetTimestampLinks is not supported by the mock client, which does not link
+// timestamps.
+func (c *TSAClient) GetTimestampLinks(_ *ts.GetTimestampLinksParams, _ ...ts.ClientOption) (*ts.GetTimestampLinksOK, error) {
+	return nil, errors.New("linked timestamps are not supported by the mock client")
+}
+
 func (c *TSAClient) SetTransport(_ runtime.ClientTransport) {
 	// nothing to do
 }
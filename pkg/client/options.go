@@ -27,6 +27,7 @@ type Option func(*options)
 type options struct {
 	UserAgent   string
 	ContentType string
+	SocketPath  string
 }
 
 func makeOptions(opts ...Option) *options {
@@ -56,6 +57,14 @@ func WithContentType(contentType string) Option {
 	}
 }
 
+// WithUnixSocket sends requests to the server listening on the unix socket at
+// path, instead of the host of the server URL.
+func WithUnixSocket(path string) Option {
+	return func(o *options) {
+		o.SocketPath = path
+	}
+}
+
 type roundTripper struct {
 	http.RoundTripper
 	UserAgent   string
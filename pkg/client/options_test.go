@@ -34,6 +34,10 @@ func TestMakeOptions(t *testing.T) {
 		desc: "WithUserAgent",
 		opts: []Option{WithUserAgent("test user agent")},
 		want: &options{UserAgent: "test user agent"},
+	}, {
+		desc: "WithUnixSocket",
+		opts: []Option{WithUnixSocket("/run/tsa.sock")},
+		want: &options{SocketPath: "/run/tsa.sock"},
 	}}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
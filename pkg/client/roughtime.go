@@ -0,0 +1,68 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/roughtime"
+)
+
+// defaultRoughtimeTimeout bounds a Roughtime query when ctx has no deadline.
+const defaultRoughtimeTimeout = 5 * time.Second
+
+// GetRoughtime queries the Roughtime responder at address, such as
+// tsa.example.com:2002, and returns its time once the response is verified
+// against the long-term public key of the server. The query is sent once,
+// and times out after 5 seconds unless ctx has an earlier deadline.
+func GetRoughtime(ctx context.Context, address string, publicKey ed25519.PublicKey) (*roughtime.Time, error) {
+	request, _, err := roughtime.CreateRequest(rand.Reader, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRoughtimeTimeout)
+		defer cancel()
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(request); err != nil {
+		return nil, fmt.Errorf("sending roughtime request: %w", err)
+	}
+
+	// a response is never larger than the request
+	buf := make([]byte, len(request))
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, fmt.Errorf("reading roughtime response: %w", err)
+	}
+	return roughtime.VerifyResponse(request, buf[:n], publicKey)
+}
@@ -15,6 +15,9 @@
 package client
 
 import (
+	"context"
+	"net"
+	"net/http"
 	"net/url"
 
 	"github.com/go-openapi/runtime"
@@ -38,9 +41,25 @@ func GetTimestampClient(timestampServerURL string, opts ...Option) (*client.Time
 	rt.Consumers["application/timestamp-reply"] = runtime.ByteStreamConsumer()
 	rt.Consumers["application/json"] = runtime.JSONConsumer()
 	rt.Consumers["application/pem-certificate-chain"] = runtime.TextConsumer()
+	rt.Consumers["application/pkcs7-mime"] = runtime.ByteStreamConsumer()
+	rt.Consumers["application/pkix-cert"] = runtime.ByteStreamConsumer()
 
+	if o.SocketPath != "" {
+		rt.Transport = unixSocketTransport(o.SocketPath)
+	}
 	rt.Transport = createRoundTripper(rt.Transport, o)
 
 	registry := strfmt.Default
 	return client.New(rt, registry), nil
 }
+
+// unixSocketTransport returns a transport dialing the unix socket at path,
+// whatever the host of the request.
+func unixSocketTransport(path string) http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+	return transport
+}
@@ -0,0 +1,432 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config defines the versioned configuration file of the timestamp
+// server. Files are decoded strictly, rejecting unknown keys, and Validate
+// reports every problem in a configuration at once.
+package config
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+)
+
+// Version is the supported version of the configuration schema.
+const Version = "v1"
+
+// Config is the configuration of the timestamp server.
+type Config struct {
+	// Version of the configuration schema. Must be set to Version.
+	Version   string          `yaml:"version"`
+	Signer    SignerConfig    `yaml:"signer"`
+	Chain     ChainConfig     `yaml:"chain"`
+	NTP       NTPConfig       `yaml:"ntp"`
+	Listeners ListenersConfig `yaml:"listeners"`
+	TLS       TLSConfig       `yaml:"tls"`
+	Metrics   MetricsConfig   `yaml:"metrics"`
+	Policies  PoliciesConfig  `yaml:"policies"`
+	// TrustedRoot configures the Sigstore TrustedRoot entries describing the server.
+	TrustedRoot TrustedRootConfig `yaml:"trusted_root"`
+	Audit       AuditConfig       `yaml:"audit"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Admin       AdminConfig       `yaml:"admin"`
+	Shutdown    ShutdownConfig    `yaml:"shutdown"`
+	Artifacts   ArtifactsConfig   `yaml:"artifacts"`
+	GRPC        GRPCConfig        `yaml:"grpc"`
+	Linking     LinkingConfig     `yaml:"linking"`
+	Roughtime   RoughtimeConfig   `yaml:"roughtime"`
+}
+
+// SignerConfig configures the key used to sign timestamps.
+type SignerConfig struct {
+	// Type is one of kms, tink, memory or file.
+	Type string `yaml:"type"`
+	// Hash is the hash algorithm used by the signer, one of sha256, sha384 or sha512.
+	Hash string           `yaml:"hash"`
+	KMS  KMSSignerConfig  `yaml:"kms"`
+	Tink TinkSignerConfig `yaml:"tink"`
+	File FileSignerConfig `yaml:"file"`
+	// VerifyAfterSign verifies every signed response before it is returned.
+	VerifyAfterSign bool `yaml:"verify_after_sign"`
+	// TripOnVerifyFailure stops signing once a response fails verification,
+	// until the server is reloaded or restarted. Requires VerifyAfterSign.
+	TripOnVerifyFailure bool `yaml:"trip_on_verify_failure"`
+}
+
+// KMSSignerConfig configures a KMS signer.
+type KMSSignerConfig struct {
+	// KeyResource is the URI of the KMS key, e.g. gcpkms://resource.
+	KeyResource string `yaml:"key_resource"`
+}
+
+// TinkSignerConfig configures a Tink signer.
+type TinkSignerConfig struct {
+	// KeyResource is the URI of the KMS key encrypting the keyset.
+	KeyResource string `yaml:"key_resource"`
+	// KeysetPath is the path to the encrypted keyset.
+	KeysetPath string `yaml:"keyset_path"`
+	// HCVaultToken authenticates Hashicorp Vault API calls.
+	HCVaultToken string `yaml:"hcvault_token"`
+}
+
+// FileSignerConfig configures a file signer.
+type FileSignerConfig struct {
+	// KeyPath is the path to the PEM-encoded private key.
+	KeyPath string `yaml:"key_path"`
+	// Password decrypts the private key.
+	Password string `yaml:"password"`
+}
+
+// ChainConfig configures the timestamping certificate chain.
+type ChainConfig struct {
+	// Path to the PEM-encoded chain. Required for all signers but memory.
+	Path string `yaml:"path"`
+	// ExpiryWarning is the duration before a certificate expires at which to start warning.
+	ExpiryWarning time.Duration `yaml:"expiry_warning"`
+	// ExpiryFailReadiness fails readiness while inside the expiry warning window.
+	ExpiryFailReadiness bool `yaml:"expiry_fail_readiness"`
+	// History lists the chains used before the current chain, which are still
+	// needed to verify timestamps issued with them.
+	History []HistoricalChainConfig `yaml:"history"`
+}
+
+// HistoricalChainConfig configures a certificate chain previously used by the server.
+type HistoricalChainConfig struct {
+	// Path to the PEM-encoded chain.
+	Path string `yaml:"path"`
+	// ValidFrom is when the chain started being used. Defaults to the time all
+	// certificates in the chain became valid.
+	ValidFrom time.Time `yaml:"valid_from"`
+	// ValidUntil is when the chain stopped being used. Defaults to the time the
+	// first certificate in the chain expires.
+	ValidUntil time.Time `yaml:"valid_until"`
+}
+
+// NTPConfig configures monitoring of the local clock against NTP servers.
+type NTPConfig struct {
+	// Disabled turns off NTP monitoring.
+	Disabled bool `yaml:"disabled"`
+	// ConfigPath is the path to a NTP monitoring configuration. The default
+	// configuration is used when empty.
+	ConfigPath string `yaml:"config_path"`
+	// CorrectTime corrects the generation time of timestamps with the
+	// consensus offset of the NTP servers.
+	CorrectTime bool `yaml:"correct_time"`
+	// MaxCorrection caps the correction applied to the generation time.
+	MaxCorrection time.Duration `yaml:"max_correction"`
+}
+
+// ListenersConfig configures the API listeners.
+type ListenersConfig struct {
+	Host string `yaml:"host"`
+	// Port for insecure connections, a random port when 0.
+	Port int `yaml:"port"`
+	// Schemes are the listeners to enable: http, https or unix.
+	Schemes []string `yaml:"schemes"`
+	// HTTPPingOnly limits the http listener to the /ping and /ready entrypoints.
+	HTTPPingOnly bool          `yaml:"http_ping_only"`
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	Socket       SocketConfig  `yaml:"socket"`
+}
+
+// SocketConfig configures the unix listener.
+type SocketConfig struct {
+	// Path of the socket. Required when the unix scheme is enabled.
+	Path string `yaml:"path"`
+	// Mode is the octal permission of the socket, e.g. 0660.
+	Mode string `yaml:"mode"`
+	// Owner and Group of the socket, as names or numeric IDs. Unchanged when empty.
+	Owner string `yaml:"owner"`
+	Group string `yaml:"group"`
+}
+
+// FileMode parses Mode.
+func (s SocketConfig) FileMode() (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s.Mode, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("invalid mode %q, expected octal permissions such as 0660", s.Mode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// TLSConfig configures the https listener.
+type TLSConfig struct {
+	Host string `yaml:"host"`
+	// Port for secure connections, a random port when 0.
+	Port int `yaml:"port"`
+	// Certificate is the path to the PEM-encoded serving certificate.
+	Certificate string `yaml:"certificate"`
+	// Key is the path to the PEM-encoded serving key.
+	Key string `yaml:"key"`
+	// CACertificate is the path to the PEM-encoded CA used to verify client certificates.
+	CACertificate string `yaml:"ca_certificate"`
+	// ClientAuth is one of none, optional or require. Defaults to require when
+	// CACertificate is set, and none otherwise.
+	ClientAuth string `yaml:"client_auth"`
+	// MinVersion is the minimum TLS version, 1.2 or 1.3.
+	MinVersion string `yaml:"min_version"`
+}
+
+// MetricsConfig configures the metrics and profiling servers.
+type MetricsConfig struct {
+	// Address of the prometheus metrics server.
+	Address string      `yaml:"address"`
+	Pprof   PprofConfig `yaml:"pprof"`
+}
+
+// PprofConfig configures the pprof server.
+type PprofConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+}
+
+// PoliciesConfig configures the TSA policies of issued timestamps.
+type PoliciesConfig struct {
+	// Default is the policy OID used when a request does not ask for one.
+	Default string `yaml:"default"`
+	// Accepted lists additional policy OIDs a request may ask for. Any policy
+	// is accepted when empty.
+	Accepted []string `yaml:"accepted"`
+	// RequireCertificatePolicy only issues timestamps under policies listed in
+	// the certificatePolicies extension of the leaf certificate.
+	RequireCertificatePolicy bool `yaml:"require_certificate_policy"`
+	// Accuracy of the generation time of issued timestamps.
+	Accuracy time.Duration `yaml:"accuracy"`
+}
+
+// TrustedRootConfig configures the Sigstore TrustedRoot entries describing the server.
+type TrustedRootConfig struct {
+	// URI identifying the timestamp authority, usually the URL of its timestamp
+	// endpoint. Defaults to the timestamp endpoint of the host serving the request.
+	URI string `yaml:"uri"`
+}
+
+// AuditConfig configures the audit log of timestamp requests.
+type AuditConfig struct {
+	// Path of the audit log. No audit log is written when empty.
+	Path string `yaml:"path"`
+	// CheckpointInterval is how often the head of the audit log is timestamped.
+	CheckpointInterval time.Duration `yaml:"checkpoint_interval"`
+	// FailClosed stops issuance once a record cannot be written, until the
+	// issuer is replaced by a reload or restart.
+	FailClosed bool `yaml:"fail_closed"`
+}
+
+// TracingConfig configures OpenTelemetry tracing.
+type TracingConfig struct {
+	// Exporter is one of none, otlp, stdout or file.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the URL of the OTLP/HTTP collector. Defaults to the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable.
+	Endpoint string `yaml:"endpoint"`
+	// Path of the file spans are written to by the file exporter.
+	Path string `yaml:"path"`
+	// SampleRatio is the fraction of traces not started by a caller that are sampled.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// AdminConfig configures the admin server, which serves metrics, profiles and
+// runtime controls of the server.
+type AdminConfig struct {
+	// Address of the admin server. The admin server is disabled when empty.
+	Address string `yaml:"address"`
+	// TokenFile is the path to a file holding the bearer token required by
+	// every admin request. No token is required when empty.
+	TokenFile string         `yaml:"token_file"`
+	TLS       AdminTLSConfig `yaml:"tls"`
+}
+
+// AdminTLSConfig configures TLS on the admin server.
+type AdminTLSConfig struct {
+	// Certificate is the path to the PEM-encoded serving certificate. The
+	// admin server uses plain HTTP when empty.
+	Certificate string `yaml:"certificate"`
+	// Key is the path to the PEM-encoded serving key.
+	Key string `yaml:"key"`
+}
+
+// ShutdownConfig configures how the server stops on SIGINT or SIGTERM.
+type ShutdownConfig struct {
+	// ReadinessDelay is how long readiness fails before new timestamp requests
+	// are refused, giving load balancers time to stop routing traffic.
+	ReadinessDelay time.Duration `yaml:"readiness_delay"`
+	// GracePeriod is how long in-flight requests are given to complete once
+	// new timestamp requests are refused.
+	GracePeriod time.Duration `yaml:"grace_period"`
+}
+
+// ArtifactsConfig configures the endpoint hashing uploaded artifacts for
+// clients that cannot build a timestamp request.
+type ArtifactsConfig struct {
+	// Enabled turns on the endpoint, which is disabled by default.
+	Enabled bool `yaml:"enabled"`
+	// MaxSize is the maximum size of an uploaded artifact, in bytes.
+	MaxSize int64 `yaml:"max_size"`
+}
+
+// GRPCConfig configures the gRPC API, served alongside the REST API.
+type GRPCConfig struct {
+	// Address of the gRPC listener. The gRPC API is disabled when empty.
+	Address string `yaml:"address"`
+	// TLS serves the gRPC API over TLS, with the certificate, key and client
+	// authentication of the tls section.
+	TLS bool `yaml:"tls"`
+}
+
+// LinkingConfig configures linked timestamps, where each timestamp carries
+// the head of a hash chain of the timestamps issued before it.
+type LinkingConfig struct {
+	// Path of the link chain. Timestamps are not linked when empty.
+	Path string `yaml:"path"`
+}
+
+// RoughtimeConfig configures the Roughtime responder, which answers requests
+// for signed, coarse proofs of the current time over UDP.
+type RoughtimeConfig struct {
+	// Address of the UDP listener. The responder is disabled when empty.
+	Address string `yaml:"address"`
+	// KeyPath is the path to the PEM-encoded PKCS#8 Ed25519 long-term key.
+	// Defaults to the timestamp signer when it is an Ed25519 key, or to an
+	// in-memory key with the memory signer.
+	KeyPath string `yaml:"key_path"`
+	// Radius is the accuracy claimed in responses, rounded up to seconds.
+	Radius time.Duration `yaml:"radius"`
+	// DelegationValidity is how long a delegated key is certified for by the
+	// long-term key. Delegated keys are replaced once half of it has passed.
+	DelegationValidity time.Duration `yaml:"delegation_validity"`
+}
+
+// Default returns the configuration used for any value that is not set.
+func Default() *Config {
+	return &Config{
+		Version: Version,
+		Signer: SignerConfig{
+			Type: "memory",
+			Hash: "sha256",
+		},
+		Chain: ChainConfig{
+			ExpiryWarning: 30 * 24 * time.Hour,
+		},
+		NTP: NTPConfig{
+			MaxCorrection: issuer.DefaultMaxClockCorrection,
+		},
+		Listeners: ListenersConfig{
+			Host:         "localhost",
+			Schemes:      []string{"http"},
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: 30 * time.Second,
+			Socket: SocketConfig{
+				Mode: "0660",
+			},
+		},
+		TLS: TLSConfig{
+			Host:       "localhost",
+			MinVersion: "1.2",
+		},
+		Metrics: MetricsConfig{
+			Address: ":2112",
+			Pprof: PprofConfig{
+				Address: ":6060",
+			},
+		},
+		Policies: PoliciesConfig{
+			Default:  "1.3.6.1.4.1.57264.2",
+			Accuracy: time.Second,
+		},
+		Audit: AuditConfig{
+			CheckpointInterval: time.Hour,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		Shutdown: ShutdownConfig{
+			GracePeriod: 15 * time.Second,
+		},
+		Artifacts: ArtifactsConfig{
+			MaxSize: 32 << 20,
+		},
+		Roughtime: RoughtimeConfig{
+			Radius:             time.Second,
+			DelegationValidity: 24 * time.Hour,
+		},
+	}
+}
+
+// Load reads a configuration file. See Parse.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %s %w", path, err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a configuration on top of the defaults, rejecting unknown
+// keys. A configuration is returned alongside decoding errors when the YAML
+// is well-formed, so that it can still be validated.
+func Parse(data []byte) (*Config, error) {
+	cfg := Default()
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("failed to parse YAML: %w", err)
+		}
+		errs := make([]error, 0, len(typeErr.Errors))
+		for _, e := range typeErr.Errors {
+			errs = append(errs, errors.New(e))
+		}
+		return cfg, errors.Join(errs...)
+	}
+	return cfg, nil
+}
+
+// ParseOID parses a dotted-decimal object identifier such as 1.2.3.4.
+func ParseOID(s string) (asn1.ObjectIdentifier, error) {
+	return tsx509.ParseOID(s)
+}
+
+// DefaultPolicy returns the parsed default policy OID.
+func (p PoliciesConfig) DefaultPolicy() (asn1.ObjectIdentifier, error) {
+	return ParseOID(p.Default)
+}
+
+// AcceptedPolicies returns the parsed accepted policy OIDs.
+func (p PoliciesConfig) AcceptedPolicies() ([]asn1.ObjectIdentifier, error) {
+	oids := make([]asn1.ObjectIdentifier, 0, len(p.Accepted))
+	for _, s := range p.Accepted {
+		oid, err := ParseOID(s)
+		if err != nil {
+			return nil, err
+		}
+		oids = append(oids, oid)
+	}
+	return oids, nil
+}
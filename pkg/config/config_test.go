@@ -0,0 +1,196 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/asn1"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultIsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("unexpected error validating default configuration: %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	dir := t.TempDir()
+	chainPath := filepath.Join(dir, "chain.pem")
+	if err := os.WriteFile(chainPath, []byte("chain"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Parse([]byte(`
+version: v1
+signer:
+  type: kms
+  hash: sha384
+  kms:
+    key_resource: gcpkms://key
+chain:
+  path: ` + chainPath + `
+ntp:
+  disabled: true
+listeners:
+  port: 3000
+  read_timeout: 5s
+policies:
+  accepted: [1.2.3.4]
+`))
+	if err != nil {
+		t.Fatalf("unexpected error parsing configuration: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error validating configuration: %v", err)
+	}
+
+	if cfg.Signer.KMS.KeyResource != "gcpkms://key" || cfg.Signer.Hash != "sha384" {
+		t.Fatalf("unexpected signer configuration: %+v", cfg.Signer)
+	}
+	if cfg.Listeners.Port != 3000 || cfg.Listeners.ReadTimeout != 5*time.Second {
+		t.Fatalf("unexpected listeners configuration: %+v", cfg.Listeners)
+	}
+	// unset values keep their defaults
+	if cfg.Listeners.WriteTimeout != 30*time.Second || cfg.Metrics.Address != ":2112" {
+		t.Fatalf("expected defaults to be kept: %+v", cfg)
+	}
+	accepted, err := cfg.Policies.AcceptedPolicies()
+	if err != nil || len(accepted) != 1 || !accepted[0].Equal(asn1.ObjectIdentifier{1, 2, 3, 4}) {
+		t.Fatalf("unexpected accepted policies: %v %v", accepted, err)
+	}
+}
+
+func TestParseRejectsUnknownKeys(t *testing.T) {
+	cfg, err := Parse([]byte(`
+version: v1
+signer:
+  typo: memory
+listener:
+  port: 3000
+`))
+	if cfg == nil {
+		t.Fatal("expected configuration to be returned with decoding errors")
+	}
+	errs := Errors(err)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "field typo not found") || !strings.Contains(errs[1].Error(), "field listener not found") {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if _, err := Parse([]byte("version: [")); err == nil {
+		t.Fatal("expected error parsing malformed YAML")
+	}
+}
+
+func TestValidateReportsAllErrors(t *testing.T) {
+	cfg := Default()
+	cfg.Version = "v0"
+	cfg.Signer.Type = "file"
+	cfg.Signer.Hash = "md5"
+	cfg.Signer.TripOnVerifyFailure = true
+	cfg.NTP.ConfigPath = "/does/not/exist"
+	cfg.NTP.CorrectTime = true
+	cfg.NTP.MaxCorrection = 0
+	cfg.Listeners.Schemes = []string{"http", "https", "unix", "ftp"}
+	cfg.Listeners.Socket.Mode = "rw-rw----"
+	cfg.TLS.MinVersion = "1.1"
+	cfg.TLS.ClientAuth = "require"
+	cfg.Metrics.Address = "2112"
+	cfg.Policies.Default = "1"
+	cfg.Policies.Accuracy = 0
+	cfg.Chain.History = []HistoricalChainConfig{{
+		Path:       "/does/not/exist",
+		ValidFrom:  time.Now(),
+		ValidUntil: time.Now().Add(-time.Hour),
+	}}
+	cfg.TrustedRoot.URI = "tsa.example.com"
+	cfg.Audit.Path = "/does/not/exist/audit.log"
+	cfg.Audit.CheckpointInterval = 0
+	cfg.Tracing.Exporter = "zipkin"
+	cfg.Tracing.SampleRatio = 2
+	cfg.Admin.Address = "localhost"
+	cfg.Admin.TLS.Key = "/does/not/exist"
+	cfg.Shutdown.GracePeriod = -time.Second
+	cfg.Artifacts = ArtifactsConfig{Enabled: true}
+	cfg.GRPC.Address = "3001"
+	cfg.Linking.Path = "/does/not/exist/links.jsonl"
+	cfg.Roughtime = RoughtimeConfig{Address: "2002", KeyPath: "/does/not/exist"}
+
+	expected := []string{
+		"version:",
+		"signer.hash:",
+		"signer.file.key_path:",
+		"signer.trip_on_verify_failure: requires signer.verify_after_sign",
+		"chain.path:",
+		"chain.history[0].path:",
+		"chain.history[0].valid_until: must be after valid_from",
+		"ntp.max_correction: must be positive",
+		"ntp.config_path:",
+		"listeners.schemes: unsupported scheme \"ftp\"",
+		"listeners.socket.path: must be set",
+		"listeners.socket.mode: invalid mode",
+		"tls.certificate:",
+		"tls.key:",
+		"tls.min_version: unsupported version \"1.1\"",
+		"tls.client_auth: require requires tls.ca_certificate",
+		"listeners.http_ping_only: must be set when client certificates are required",
+		"metrics.address:",
+		"policies.default:",
+		"policies.accuracy:",
+		"trusted_root.uri: must be an absolute URI",
+		"audit.path:",
+		"audit.checkpoint_interval: must be positive",
+		"tracing.exporter: unsupported exporter \"zipkin\"",
+		"tracing.sample_ratio: must be between 0 and 1",
+		"admin.address:",
+		"admin.tls.certificate: must be set",
+		"admin.tls.key:",
+		"shutdown.grace_period: must not be negative",
+		"artifacts.max_size: must be positive",
+		"grpc.address:",
+		"linking.path:",
+		"roughtime.address:",
+		"roughtime.key_path:",
+		"roughtime.radius: must be positive",
+		"roughtime.delegation_validity: must be at least 1m",
+	}
+	errs := Errors(cfg.Validate())
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range errs {
+		if !strings.HasPrefix(e.Error(), expected[i]) {
+			t.Fatalf("expected error starting with %q, got %q", expected[i], e)
+		}
+	}
+}
+
+func TestValidateAuditFailClosed(t *testing.T) {
+	cfg := Default()
+	cfg.Audit.FailClosed = true
+	for _, e := range Errors(cfg.Validate()) {
+		if strings.HasPrefix(e.Error(), "audit.fail_closed: requires audit.path") {
+			return
+		}
+	}
+	t.Fatal("expected audit.fail_closed to require audit.path")
+}
@@ -0,0 +1,336 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// supportedDigests are the hash algorithms accepted in timestamp requests.
+var supportedDigests = []string{"sha256", "sha384", "sha512"}
+
+// Unmapped is a setting of an OpenSSL TSA configuration that has no
+// equivalent in the server configuration.
+type Unmapped struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+func (u Unmapped) String() string {
+	return fmt.Sprintf("%s = %s: %s", u.Key, u.Value, u.Reason)
+}
+
+// OpenSSLImport is an OpenSSL TSA configuration translated into a server
+// configuration.
+type OpenSSLImport struct {
+	// Config is the translated configuration. Its chain.path is left empty, as
+	// the certificate chain is split across the files in CertPaths.
+	Config *Config
+	// CertPaths are the PEM files holding the signer certificate (signer_cert)
+	// and the rest of its chain (certs), which must be combined into a single
+	// chain, starting with the signer certificate and ending with the root.
+	CertPaths []string
+	// Unmapped lists every setting that could not be translated.
+	Unmapped []Unmapped
+}
+
+// opensslConfig is a parsed OpenSSL configuration file, mapping each section
+// to its settings. Settings before the first section are in the default section.
+type opensslConfig map[string]map[string]string
+
+const opensslDefaultSection = "default"
+
+var (
+	opensslSectionRE = regexp.MustCompile(`^\[\s*([^\]\s]+)\s*\]$`)
+	opensslVarRE     = regexp.MustCompile(`\$(?:\{([\w.:]+)\}|\(([\w.:]+)\)|([\w.]+(?:::[\w.]+)?))`)
+)
+
+// parseOpenSSLConfig parses the subset of the OpenSSL configuration syntax
+// used by TSA configurations: sections, key = value settings, comments, line
+// continuations, and $var, ${var} and $section::var expansions.
+func parseOpenSSLConfig(data []byte) (opensslConfig, error) {
+	cfg := opensslConfig{opensslDefaultSection: {}}
+	section := opensslDefaultSection
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	var pending string
+	for sc.Scan() {
+		lineNo++
+		line := pending + sc.Text()
+		pending = ""
+		if strings.HasSuffix(line, `\`) {
+			pending = strings.TrimSuffix(line, `\`)
+			continue
+		}
+		line = strings.TrimSpace(stripOpenSSLComment(line))
+		if line == "" {
+			continue
+		}
+		if m := opensslSectionRE.FindStringSubmatch(line); m != nil {
+			section = m[1]
+			if cfg[section] == nil {
+				cfg[section] = map[string]string{}
+			}
+			continue
+		}
+		if strings.HasPrefix(line, ".") {
+			return nil, fmt.Errorf("line %d: directive %q is not supported", lineNo, line)
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value, got %q", lineNo, line)
+		}
+		key = strings.TrimSpace(key)
+		value, err := cfg.expand(section, unquoteOpenSSL(strings.TrimSpace(value)))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		cfg[section][key] = value
+	}
+	return cfg, sc.Err()
+}
+
+// stripOpenSSLComment removes a comment starting with # outside of quotes.
+func stripOpenSSLComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func unquoteOpenSSL(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// expand replaces the variables in value with settings of the section, of
+// the default section, of a named section with $section::name, or of the
+// environment with $ENV::name.
+func (c opensslConfig) expand(section, value string) (string, error) {
+	var err error
+	expanded := opensslVarRE.ReplaceAllStringFunc(value, func(m string) string {
+		sub := opensslVarRE.FindStringSubmatch(m)
+		name := sub[1] + sub[2] + sub[3]
+		sec := section
+		if s, n, ok := strings.Cut(name, "::"); ok {
+			sec, name = s, n
+		}
+		if sec == "ENV" {
+			if v, ok := os.LookupEnv(name); ok {
+				return v
+			}
+		} else if v, ok := c[sec][name]; ok {
+			return v
+		} else if v, ok := c[opensslDefaultSection][name]; ok {
+			return v
+		}
+		if err == nil {
+			err = fmt.Errorf("variable %s has no value", m)
+		}
+		return m
+	})
+	return expanded, err
+}
+
+// oid resolves a policy given by a dotted-decimal OID or by a name defined in
+// the OID section of the configuration.
+func (c opensslConfig) oid(value string) (string, error) {
+	if _, err := ParseOID(value); err == nil {
+		return value, nil
+	}
+	if section := c[opensslDefaultSection]["oid_section"]; section != "" {
+		if v, ok := c[section][value]; ok {
+			// a long name may precede the OID, as in "name, 1.2.3"
+			if _, after, ok := strings.Cut(v, ","); ok {
+				v = strings.TrimSpace(after)
+			}
+			if _, err := ParseOID(v); err == nil {
+				return v, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unknown policy %q", value)
+}
+
+func splitOpenSSLList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseOpenSSLAccuracy parses an accuracy such as "secs:1, millisecs:500".
+func parseOpenSSLAccuracy(value string) (time.Duration, error) {
+	units := map[string]time.Duration{"secs": time.Second, "millisecs": time.Millisecond, "microsecs": time.Microsecond}
+	var accuracy time.Duration
+	for _, part := range splitOpenSSLList(value) {
+		unit, n, ok := strings.Cut(part, ":")
+		d, known := units[strings.TrimSpace(unit)]
+		v, err := strconv.Atoi(strings.TrimSpace(n))
+		if !ok || !known || err != nil || v < 0 {
+			return 0, fmt.Errorf("invalid accuracy %q", part)
+		}
+		accuracy += time.Duration(v) * d
+	}
+	return accuracy, nil
+}
+
+func isOpenSSLTrue(value string) bool {
+	return slices.Contains([]string{"yes", "y", "true", "on", "1"}, strings.ToLower(value))
+}
+
+// ImportOpenSSL translates the TSA section of an OpenSSL configuration, as
+// used by openssl ts -reply, into a server configuration. The section is the
+// one named by default_tsa in the tsa section when empty.
+func ImportOpenSSL(data []byte, section string) (*OpenSSLImport, error) {
+	conf, err := parseOpenSSLConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	if section == "" {
+		section = conf["tsa"]["default_tsa"]
+		if section == "" {
+			return nil, fmt.Errorf("no TSA section given, and no default_tsa in the [ tsa ] section")
+		}
+	}
+	settings, ok := conf[section]
+	if !ok {
+		return nil, fmt.Errorf("section %q not found", section)
+	}
+
+	imp := &OpenSSLImport{Config: Default()}
+	cfg := imp.Config
+	unmapped := func(key, reason string) {
+		imp.Unmapped = append(imp.Unmapped, Unmapped{Key: key, Value: settings[key], Reason: reason})
+	}
+
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := settings[key]
+		switch key {
+		case "dir":
+			// only used in expansions
+		case "signer_key":
+			cfg.Signer.Type = "file"
+			cfg.Signer.File.KeyPath = value
+		case "signer_cert":
+			imp.CertPaths = append([]string{value}, imp.CertPaths...)
+		case "certs":
+			imp.CertPaths = append(imp.CertPaths, value)
+		case "signer_digest":
+			if d := strings.ToLower(strings.ReplaceAll(value, "-", "")); slices.Contains(supportedDigests, d) {
+				cfg.Signer.Hash = d
+			} else {
+				unmapped(key, fmt.Sprintf("the signer hash must be one of %v", supportedDigests))
+			}
+		case "default_policy":
+			oid, err := conf.oid(value)
+			if err != nil {
+				unmapped(key, err.Error())
+				continue
+			}
+			cfg.Policies.Default = oid
+		case "other_policies":
+			for _, p := range splitOpenSSLList(value) {
+				oid, err := conf.oid(p)
+				if err != nil {
+					unmapped(key, err.Error())
+					continue
+				}
+				cfg.Policies.Accepted = append(cfg.Policies.Accepted, oid)
+			}
+		case "digests":
+			var missing []string
+			for _, d := range splitOpenSSLList(value) {
+				d = strings.ToLower(strings.ReplaceAll(d, "-", ""))
+				if !slices.Contains(supportedDigests, d) {
+					missing = append(missing, d)
+				}
+			}
+			if len(missing) > 0 || len(splitOpenSSLList(value)) != len(supportedDigests) {
+				unmapped(key, fmt.Sprintf("the accepted hash algorithms are not configurable, requests hashed with any of %v are accepted", supportedDigests))
+			}
+		case "accuracy":
+			accuracy, err := parseOpenSSLAccuracy(value)
+			if err != nil {
+				unmapped(key, err.Error())
+				continue
+			}
+			cfg.Policies.Accuracy = accuracy
+		case "clock_precision_digits":
+			if value != "0" {
+				unmapped(key, "the generation time is encoded in whole seconds")
+			}
+		case "ordering", "tsa_name", "ess_cert_id_chain":
+			if isOpenSSLTrue(value) {
+				unmapped(key, map[string]string{
+					"ordering":          "timestamps are never ordered",
+					"tsa_name":          "the TSA name is not included in timestamps",
+					"ess_cert_id_chain": "only the signer certificate is identified in timestamps",
+				}[key])
+			}
+		case "ess_cert_id_alg":
+			unmapped(key, "the signer certificate is identified by an ESSCertIDv2 hashed with the hash algorithm of the request")
+		case "serial":
+			unmapped(key, "serial numbers are random, and need no serial file")
+		case "crypto_device":
+			if value != "builtin" {
+				unmapped(key, "engines are not supported, use a kms or tink signer instead")
+			}
+		default:
+			unmapped(key, "unknown setting")
+		}
+	}
+
+	// openssl only accepts the default and other policies, while an empty list
+	// of accepted policies accepts any policy
+	if len(cfg.Policies.Accepted) == 0 && settings["default_policy"] != "" {
+		cfg.Policies.Accepted = []string{cfg.Policies.Default}
+	}
+	if settings["signer_key"] == "" {
+		unmapped("signer_key", "no signer key is configured, an in-memory key is used")
+	}
+	return imp, nil
+}
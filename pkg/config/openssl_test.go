@@ -0,0 +1,142 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const opensslTSAConfig = `
+# OpenSSL configuration of a TSA
+HOME = .
+oid_section = new_oids
+
+[ new_oids ]
+tsa_policy1 = 1.2.3.4.1
+tsa_policy2 = TSA policy 2, 1.2.3.4.5.6
+
+[ tsa ]
+default_tsa = tsa_config1
+
+[ tsa_config1 ]
+dir             = ./demoCA                  # TSA root directory
+serial          = $dir/tsaserial
+crypto_device   = builtin
+signer_cert     = $dir/tsacert.pem
+certs           = ${dir}/cacert.pem
+signer_key      = $dir/private/tsakey.pem
+signer_digest   = sha384
+default_policy  = tsa_policy1
+other_policies  = tsa_policy2, 1.2.3.4.7
+digests         = sha1, sha256, sha384, sha512
+accuracy        = secs:1, millisecs:500, \
+                  microsecs:100
+clock_precision_digits = 0
+ordering        = yes
+tsa_name        = yes
+ess_cert_id_chain = no
+ess_cert_id_alg = sha1
+`
+
+func TestImportOpenSSL(t *testing.T) {
+	imp, err := ImportOpenSSL([]byte(opensslTSAConfig), "")
+	if err != nil {
+		t.Fatalf("unexpected error importing configuration: %v", err)
+	}
+	cfg := imp.Config
+	if cfg.Signer.Type != "file" || cfg.Signer.File.KeyPath != "./demoCA/private/tsakey.pem" || cfg.Signer.Hash != "sha384" {
+		t.Fatalf("unexpected signer %+v", cfg.Signer)
+	}
+	if want := []string{"./demoCA/tsacert.pem", "./demoCA/cacert.pem"}; !reflect.DeepEqual(imp.CertPaths, want) {
+		t.Fatalf("expected certificate files %v, got %v", want, imp.CertPaths)
+	}
+	if cfg.Policies.Default != "1.2.3.4.1" {
+		t.Fatalf("unexpected default policy %q", cfg.Policies.Default)
+	}
+	if want := []string{"1.2.3.4.5.6", "1.2.3.4.7"}; !reflect.DeepEqual(cfg.Policies.Accepted, want) {
+		t.Fatalf("expected accepted policies %v, got %v", want, cfg.Policies.Accepted)
+	}
+	if want := 1500100 * time.Microsecond; cfg.Policies.Accuracy != want {
+		t.Fatalf("expected accuracy %v, got %v", want, cfg.Policies.Accuracy)
+	}
+
+	var keys []string
+	for _, u := range imp.Unmapped {
+		keys = append(keys, u.Key)
+	}
+	sort.Strings(keys)
+	if want := []string{"digests", "ess_cert_id_alg", "ordering", "serial", "tsa_name"}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("expected unmapped settings %v, got %v", want, keys)
+	}
+
+	// the translated configuration round-trips through YAML
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling configuration: %v", err)
+	}
+	parsed, err := Parse(out)
+	if err != nil {
+		t.Fatalf("unexpected error parsing translated configuration: %v", err)
+	}
+	if again, _ := yaml.Marshal(parsed); string(again) != string(out) {
+		t.Fatalf("expected\n%s\nafter round trip, got\n%s", out, again)
+	}
+}
+
+func TestImportOpenSSLDefaultPolicyOnly(t *testing.T) {
+	imp, err := ImportOpenSSL([]byte(`
+[ tsa_config ]
+signer_key     = key.pem
+default_policy = 1.2.3.4
+crypto_device  = chil
+unknown        = value
+`), "tsa_config")
+	if err != nil {
+		t.Fatalf("unexpected error importing configuration: %v", err)
+	}
+	// openssl only accepts the default policy without other_policies
+	if want := []string{"1.2.3.4"}; !reflect.DeepEqual(imp.Config.Policies.Accepted, want) {
+		t.Fatalf("expected accepted policies %v, got %v", want, imp.Config.Policies.Accepted)
+	}
+	if len(imp.Unmapped) != 2 || imp.Unmapped[0].Key != "crypto_device" || imp.Unmapped[1].Key != "unknown" {
+		t.Fatalf("unexpected unmapped settings %v", imp.Unmapped)
+	}
+}
+
+func TestImportOpenSSLErrors(t *testing.T) {
+	tests := map[string]struct {
+		conf    string
+		section string
+	}{
+		"no default section": {conf: "[ tsa_config ]\nsigner_key = key.pem\n"},
+		"missing section":    {conf: "[ tsa ]\ndefault_tsa = missing\n"},
+		"undefined variable": {conf: "[ tsa ]\ndefault_tsa = $missing\n"},
+		"invalid line":       {conf: "[ tsa ]\ndefault_tsa\n"},
+		"include":            {conf: ".include other.cnf\n", section: "tsa"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ImportOpenSSL([]byte(tc.conf), tc.section); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
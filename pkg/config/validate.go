@@ -0,0 +1,370 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/ntpmonitor"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+)
+
+// validator collects every error found in a configuration.
+type validator struct {
+	errs []error
+}
+
+func (v *validator) errorf(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) required(field, value string) bool {
+	if value == "" {
+		v.errorf(field, "must be set")
+		return false
+	}
+	return true
+}
+
+func (v *validator) file(field, path string) {
+	if !v.required(field, path) {
+		return
+	}
+	if _, err := os.Stat(filepath.Clean(path)); err != nil {
+		v.errorf(field, "%v", err)
+	}
+}
+
+func (v *validator) port(field string, port int) {
+	if port < 0 || port > 65535 {
+		v.errorf(field, "must be between 0 and 65535, got %d", port)
+	}
+}
+
+func (v *validator) address(field, addr string) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		v.errorf(field, "%v", err)
+	}
+}
+
+// Validate checks the configuration, returning every error found joined
+// together. Referenced files must exist.
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	if c.Version != Version {
+		v.errorf("version", "unsupported version %q, expected %q", c.Version, Version)
+	}
+
+	c.validateSigner(v)
+	c.validateNTP(v)
+	c.validateListeners(v)
+	c.validateMetrics(v)
+	c.validatePolicies(v)
+	c.validateTrustedRoot(v)
+	c.validateAudit(v)
+	c.validateTracing(v)
+	c.validateAdmin(v)
+	c.validateShutdown(v)
+	c.validateArtifacts(v)
+	c.validateGRPC(v)
+	c.validateLinking(v)
+	c.validateRoughtime(v)
+
+	return errors.Join(v.errs...)
+}
+
+func (c *Config) validateSigner(v *validator) {
+	if _, err := signer.HashToAlg(c.Signer.Hash); err != nil {
+		v.errorf("signer.hash", "%v", err)
+	}
+
+	switch c.Signer.Type {
+	case signer.MemoryScheme:
+	case signer.KMSScheme:
+		v.required("signer.kms.key_resource", c.Signer.KMS.KeyResource)
+	case signer.TinkScheme:
+		v.required("signer.tink.key_resource", c.Signer.Tink.KeyResource)
+		v.file("signer.tink.keyset_path", c.Signer.Tink.KeysetPath)
+	case signer.FileScheme:
+		v.file("signer.file.key_path", c.Signer.File.KeyPath)
+	default:
+		v.errorf("signer.type", "unsupported signer type %q, expected one of [kms, tink, memory, file]", c.Signer.Type)
+	}
+	if c.Signer.TripOnVerifyFailure && !c.Signer.VerifyAfterSign {
+		v.errorf("signer.trip_on_verify_failure", "requires signer.verify_after_sign")
+	}
+
+	// KMS, Tink and File signers require a provided certificate chain
+	if c.Signer.Type != signer.MemoryScheme {
+		v.file("chain.path", c.Chain.Path)
+	}
+	if c.Chain.ExpiryWarning < 0 {
+		v.errorf("chain.expiry_warning", "must not be negative")
+	}
+	for i, h := range c.Chain.History {
+		field := fmt.Sprintf("chain.history[%d]", i)
+		v.file(field+".path", h.Path)
+		if !h.ValidFrom.IsZero() && !h.ValidUntil.IsZero() && !h.ValidUntil.After(h.ValidFrom) {
+			v.errorf(field+".valid_until", "must be after valid_from")
+		}
+	}
+}
+
+func (c *Config) validateTrustedRoot(v *validator) {
+	if c.TrustedRoot.URI == "" {
+		return
+	}
+	if u, err := url.Parse(c.TrustedRoot.URI); err != nil {
+		v.errorf("trusted_root.uri", "%v", err)
+	} else if !u.IsAbs() {
+		v.errorf("trusted_root.uri", "must be an absolute URI, got %q", c.TrustedRoot.URI)
+	}
+}
+
+func (c *Config) validateAudit(v *validator) {
+	if c.Audit.Path == "" {
+		if c.Audit.FailClosed {
+			v.errorf("audit.fail_closed", "requires audit.path")
+		}
+		return
+	}
+	if _, err := os.Stat(filepath.Dir(filepath.Clean(c.Audit.Path))); err != nil {
+		v.errorf("audit.path", "%v", err)
+	}
+	if c.Audit.CheckpointInterval <= 0 {
+		v.errorf("audit.checkpoint_interval", "must be positive")
+	}
+}
+
+func (c *Config) validateLinking(v *validator) {
+	if c.Linking.Path == "" {
+		return
+	}
+	if _, err := os.Stat(filepath.Dir(filepath.Clean(c.Linking.Path))); err != nil {
+		v.errorf("linking.path", "%v", err)
+	}
+	if filepath.Clean(c.Linking.Path) == filepath.Clean(c.Audit.Path) {
+		v.errorf("linking.path", "must differ from audit.path")
+	}
+}
+
+func (c *Config) validateTracing(v *validator) {
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout:
+	case tracing.ExporterOTLP:
+		if c.Tracing.Endpoint != "" {
+			if u, err := url.Parse(c.Tracing.Endpoint); err != nil {
+				v.errorf("tracing.endpoint", "%v", err)
+			} else if !u.IsAbs() {
+				v.errorf("tracing.endpoint", "must be an absolute URL, got %q", c.Tracing.Endpoint)
+			}
+		}
+	case tracing.ExporterFile:
+		if v.required("tracing.path", c.Tracing.Path) {
+			if _, err := os.Stat(filepath.Dir(filepath.Clean(c.Tracing.Path))); err != nil {
+				v.errorf("tracing.path", "%v", err)
+			}
+		}
+	default:
+		v.errorf("tracing.exporter", "unsupported exporter %q, expected one of %v", c.Tracing.Exporter, tracing.Exporters)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		v.errorf("tracing.sample_ratio", "must be between 0 and 1, got %v", c.Tracing.SampleRatio)
+	}
+}
+
+func (c *Config) validateNTP(v *validator) {
+	if c.NTP.CorrectTime {
+		if c.NTP.Disabled {
+			v.errorf("ntp.correct_time", "requires ntp monitoring, which is disabled")
+		}
+		if c.NTP.MaxCorrection <= 0 {
+			v.errorf("ntp.max_correction", "must be positive")
+		}
+	}
+	if c.NTP.Disabled {
+		return
+	}
+	ntpConfig, err := ntpmonitor.LoadConfig(c.NTP.ConfigPath)
+	if err != nil {
+		v.errorf("ntp.config_path", "%v", err)
+		return
+	}
+	if err := ntpConfig.Validate(); err != nil {
+		v.errorf("ntp.config_path", "%v", err)
+	}
+}
+
+func (c *Config) validateListeners(v *validator) {
+	if len(c.Listeners.Schemes) == 0 {
+		v.errorf("listeners.schemes", "at least one scheme must be enabled")
+	}
+	for _, scheme := range c.Listeners.Schemes {
+		if !slices.Contains([]string{"http", "https", "unix"}, scheme) {
+			v.errorf("listeners.schemes", "unsupported scheme %q, expected one of [http, https, unix]", scheme)
+		}
+	}
+	v.port("listeners.port", c.Listeners.Port)
+	if c.Listeners.ReadTimeout < 0 {
+		v.errorf("listeners.read_timeout", "must not be negative")
+	}
+	if c.Listeners.WriteTimeout < 0 {
+		v.errorf("listeners.write_timeout", "must not be negative")
+	}
+
+	if slices.Contains(c.Listeners.Schemes, "unix") {
+		if v.required("listeners.socket.path", c.Listeners.Socket.Path) {
+			if _, err := os.Stat(filepath.Dir(filepath.Clean(c.Listeners.Socket.Path))); err != nil {
+				v.errorf("listeners.socket.path", "%v", err)
+			}
+		}
+		if _, err := c.Listeners.Socket.FileMode(); err != nil {
+			v.errorf("listeners.socket.mode", "%v", err)
+		}
+	}
+
+	if slices.Contains(c.Listeners.Schemes, "https") {
+		v.port("tls.port", c.TLS.Port)
+		v.file("tls.certificate", c.TLS.Certificate)
+		v.file("tls.key", c.TLS.Key)
+	}
+	if c.TLS.CACertificate != "" {
+		v.file("tls.ca_certificate", c.TLS.CACertificate)
+	}
+	if !slices.Contains([]string{"1.2", "1.3"}, c.TLS.MinVersion) {
+		v.errorf("tls.min_version", "unsupported version %q, expected 1.2 or 1.3", c.TLS.MinVersion)
+	}
+	switch c.TLS.ClientAuth {
+	case "":
+	case "none":
+		if c.TLS.CACertificate != "" {
+			v.errorf("tls.client_auth", "client certificates must be verified when tls.ca_certificate is set")
+		}
+	case "optional", "require":
+		if c.TLS.CACertificate == "" {
+			v.errorf("tls.client_auth", "%s requires tls.ca_certificate", c.TLS.ClientAuth)
+		}
+	default:
+		v.errorf("tls.client_auth", "unsupported value %q, expected one of [none, optional, require]", c.TLS.ClientAuth)
+	}
+	requireClientCert := c.TLS.ClientAuth == "require" || (c.TLS.ClientAuth == "" && c.TLS.CACertificate != "")
+	if requireClientCert && slices.Contains(c.Listeners.Schemes, "http") && !c.Listeners.HTTPPingOnly {
+		v.errorf("listeners.http_ping_only", "must be set when client certificates are required, as the http listener serves the API without them")
+	}
+}
+
+func (c *Config) validateMetrics(v *validator) {
+	v.address("metrics.address", c.Metrics.Address)
+	if c.Metrics.Pprof.Enabled {
+		v.address("metrics.pprof.address", c.Metrics.Pprof.Address)
+	}
+}
+
+func (c *Config) validateAdmin(v *validator) {
+	if c.Admin.Address == "" {
+		return
+	}
+	v.address("admin.address", c.Admin.Address)
+	if c.Admin.TokenFile != "" {
+		v.file("admin.token_file", c.Admin.TokenFile)
+	}
+	if c.Admin.TLS.Certificate != "" || c.Admin.TLS.Key != "" {
+		v.file("admin.tls.certificate", c.Admin.TLS.Certificate)
+		v.file("admin.tls.key", c.Admin.TLS.Key)
+	}
+}
+
+func (c *Config) validateShutdown(v *validator) {
+	if c.Shutdown.ReadinessDelay < 0 {
+		v.errorf("shutdown.readiness_delay", "must not be negative")
+	}
+	if c.Shutdown.GracePeriod < 0 {
+		v.errorf("shutdown.grace_period", "must not be negative")
+	}
+}
+
+func (c *Config) validateArtifacts(v *validator) {
+	if c.Artifacts.Enabled && c.Artifacts.MaxSize <= 0 {
+		v.errorf("artifacts.max_size", "must be positive")
+	}
+}
+
+func (c *Config) validateGRPC(v *validator) {
+	if c.GRPC.Address == "" {
+		return
+	}
+	v.address("grpc.address", c.GRPC.Address)
+	if c.GRPC.TLS && !slices.Contains(c.Listeners.Schemes, "https") {
+		// the https listener already checks the certificate and key
+		v.file("tls.certificate", c.TLS.Certificate)
+		v.file("tls.key", c.TLS.Key)
+	}
+}
+
+func (c *Config) validateRoughtime(v *validator) {
+	if c.Roughtime.Address == "" {
+		return
+	}
+	v.address("roughtime.address", c.Roughtime.Address)
+	if c.Roughtime.KeyPath != "" {
+		v.file("roughtime.key_path", c.Roughtime.KeyPath)
+	}
+	if c.Roughtime.Radius <= 0 {
+		v.errorf("roughtime.radius", "must be positive")
+	}
+	if c.Roughtime.DelegationValidity < time.Minute {
+		v.errorf("roughtime.delegation_validity", "must be at least 1m")
+	}
+}
+
+func (c *Config) validatePolicies(v *validator) {
+	if _, err := c.Policies.DefaultPolicy(); err != nil {
+		v.errorf("policies.default", "%v", err)
+	}
+	for _, p := range c.Policies.Accepted {
+		if _, err := ParseOID(p); err != nil {
+			v.errorf("policies.accepted", "%v", err)
+		}
+	}
+	if c.Policies.Accuracy <= 0 {
+		v.errorf("policies.accuracy", "must be positive")
+	}
+}
+
+// Errors splits an error returned by Load or Validate into the individual
+// errors it joins.
+func Errors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range joined.Unwrap() {
+			errs = append(errs, Errors(e)...)
+		}
+		return errs
+	}
+	return []error{err}
+}
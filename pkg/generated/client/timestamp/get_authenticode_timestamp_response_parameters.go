@@ -0,0 +1,166 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAuthenticodeTimestampResponseParams creates a new GetAuthenticodeTimestampResponseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAuthenticodeTimestampResponseParams() *GetAuthenticodeTimestampResponseParams {
+	return &GetAuthenticodeTimestampResponseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAuthenticodeTimestampResponseParamsWithTimeout creates a new GetAuthenticodeTimestampResponseParams object
+// with the ability to set a timeout on a request.
+func NewGetAuthenticodeTimestampResponseParamsWithTimeout(timeout time.Duration) *GetAuthenticodeTimestampResponseParams {
+	return &GetAuthenticodeTimestampResponseParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAuthenticodeTimestampResponseParamsWithContext creates a new GetAuthenticodeTimestampResponseParams object
+// with the ability to set a context for a request.
+func NewGetAuthenticodeTimestampResponseParamsWithContext(ctx context.Context) *GetAuthenticodeTimestampResponseParams {
+	return &GetAuthenticodeTimestampResponseParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAuthenticodeTimestampResponseParamsWithHTTPClient creates a new GetAuthenticodeTimestampResponseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAuthenticodeTimestampResponseParamsWithHTTPClient(client *http.Client) *GetAuthenticodeTimestampResponseParams {
+	return &GetAuthenticodeTimestampResponseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetAuthenticodeTimestampResponseParams contains all the parameters to send to the API endpoint
+
+	for the get authenticode timestamp response operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetAuthenticodeTimestampResponseParams struct {
+
+	// Request.
+	//
+	// Format: binary
+	Request io.ReadCloser
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get authenticode timestamp response params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAuthenticodeTimestampResponseParams) WithDefaults() *GetAuthenticodeTimestampResponseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get authenticode timestamp response params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAuthenticodeTimestampResponseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) WithTimeout(timeout time.Duration) *GetAuthenticodeTimestampResponseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) WithContext(ctx context.Context) *GetAuthenticodeTimestampResponseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) WithHTTPClient(client *http.Client) *GetAuthenticodeTimestampResponseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequest adds the request to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) WithRequest(request io.ReadCloser) *GetAuthenticodeTimestampResponseParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the get authenticode timestamp response params
+func (o *GetAuthenticodeTimestampResponseParams) SetRequest(request io.ReadCloser) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAuthenticodeTimestampResponseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetAuthenticodeTimestampResponseReader is a Reader for the GetAuthenticodeTimestampResponse structure.
+type GetAuthenticodeTimestampResponseReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAuthenticodeTimestampResponseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAuthenticodeTimestampResponseOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetAuthenticodeTimestampResponseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetAuthenticodeTimestampResponseDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAuthenticodeTimestampResponseOK creates a GetAuthenticodeTimestampResponseOK with default headers values
+func NewGetAuthenticodeTimestampResponseOK(writer io.Writer) *GetAuthenticodeTimestampResponseOK {
+	return &GetAuthenticodeTimestampResponseOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetAuthenticodeTimestampResponseOK describes a response with status code 200, with default header values.
+
+Returns the base64 encoded PKCS#7 countersignature
+*/
+type GetAuthenticodeTimestampResponseOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get authenticode timestamp response o k response has a 2xx status code
+func (o *GetAuthenticodeTimestampResponseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get authenticode timestamp response o k response has a 3xx status code
+func (o *GetAuthenticodeTimestampResponseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get authenticode timestamp response o k response has a 4xx status code
+func (o *GetAuthenticodeTimestampResponseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get authenticode timestamp response o k response has a 5xx status code
+func (o *GetAuthenticodeTimestampResponseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get authenticode timestamp response o k response a status code equal to that given
+func (o *GetAuthenticodeTimestampResponseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get authenticode timestamp response o k response
+func (o *GetAuthenticodeTimestampResponseOK) Code() int {
+	return 200
+}
+
+func (o *GetAuthenticodeTimestampResponseOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponseOK", 200)
+}
+
+func (o *GetAuthenticodeTimestampResponseOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponseOK", 200)
+}
+
+func (o *GetAuthenticodeTimestampResponseOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetAuthenticodeTimestampResponseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAuthenticodeTimestampResponseBadRequest creates a GetAuthenticodeTimestampResponseBadRequest with default headers values
+func NewGetAuthenticodeTimestampResponseBadRequest() *GetAuthenticodeTimestampResponseBadRequest {
+	return &GetAuthenticodeTimestampResponseBadRequest{}
+}
+
+/*
+GetAuthenticodeTimestampResponseBadRequest describes a response with status code 400, with default header values.
+
+The content supplied to the server was invalid
+*/
+type GetAuthenticodeTimestampResponseBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get authenticode timestamp response bad request response has a 2xx status code
+func (o *GetAuthenticodeTimestampResponseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get authenticode timestamp response bad request response has a 3xx status code
+func (o *GetAuthenticodeTimestampResponseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get authenticode timestamp response bad request response has a 4xx status code
+func (o *GetAuthenticodeTimestampResponseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get authenticode timestamp response bad request response has a 5xx status code
+func (o *GetAuthenticodeTimestampResponseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get authenticode timestamp response bad request response a status code equal to that given
+func (o *GetAuthenticodeTimestampResponseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get authenticode timestamp response bad request response
+func (o *GetAuthenticodeTimestampResponseBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetAuthenticodeTimestampResponseBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponseBadRequest %s", 400, payload)
+}
+
+func (o *GetAuthenticodeTimestampResponseBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponseBadRequest %s", 400, payload)
+}
+
+func (o *GetAuthenticodeTimestampResponseBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAuthenticodeTimestampResponseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAuthenticodeTimestampResponseDefault creates a GetAuthenticodeTimestampResponseDefault with default headers values
+func NewGetAuthenticodeTimestampResponseDefault(code int) *GetAuthenticodeTimestampResponseDefault {
+	return &GetAuthenticodeTimestampResponseDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetAuthenticodeTimestampResponseDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetAuthenticodeTimestampResponseDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get authenticode timestamp response default response has a 2xx status code
+func (o *GetAuthenticodeTimestampResponseDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get authenticode timestamp response default response has a 3xx status code
+func (o *GetAuthenticodeTimestampResponseDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get authenticode timestamp response default response has a 4xx status code
+func (o *GetAuthenticodeTimestampResponseDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get authenticode timestamp response default response has a 5xx status code
+func (o *GetAuthenticodeTimestampResponseDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get authenticode timestamp response default response a status code equal to that given
+func (o *GetAuthenticodeTimestampResponseDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get authenticode timestamp response default response
+func (o *GetAuthenticodeTimestampResponseDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAuthenticodeTimestampResponseDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponse default %s", o._statusCode, payload)
+}
+
+func (o *GetAuthenticodeTimestampResponseDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/authenticode][%d] getAuthenticodeTimestampResponse default %s", o._statusCode, payload)
+}
+
+func (o *GetAuthenticodeTimestampResponseDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAuthenticodeTimestampResponseDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -71,7 +71,7 @@ func NewGetTimestampCertChainOK() *GetTimestampCertChainOK {
 /*
 GetTimestampCertChainOK describes a response with status code 200, with default header values.
 
-The PEM encoded cert chain
+The cert chain in the requested format
 */
 type GetTimestampCertChainOK struct {
 	Payload string
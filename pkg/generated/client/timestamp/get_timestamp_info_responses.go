@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampInfoReader is a Reader for the GetTimestampInfo structure.
+type GetTimestampInfoReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetTimestampInfoReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetTimestampInfoOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetTimestampInfoDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetTimestampInfoOK creates a GetTimestampInfoOK with default headers values
+func NewGetTimestampInfoOK() *GetTimestampInfoOK {
+	return &GetTimestampInfoOK{}
+}
+
+/*
+GetTimestampInfoOK describes a response with status code 200, with default header values.
+
+The capabilities of the timestamp authority
+*/
+type GetTimestampInfoOK struct {
+	Payload *models.TimestampInfo
+}
+
+// IsSuccess returns true when this get timestamp info o k response has a 2xx status code
+func (o *GetTimestampInfoOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get timestamp info o k response has a 3xx status code
+func (o *GetTimestampInfoOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp info o k response has a 4xx status code
+func (o *GetTimestampInfoOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp info o k response has a 5xx status code
+func (o *GetTimestampInfoOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp info o k response a status code equal to that given
+func (o *GetTimestampInfoOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get timestamp info o k response
+func (o *GetTimestampInfoOK) Code() int {
+	return 200
+}
+
+func (o *GetTimestampInfoOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/info][%d] getTimestampInfoOK %s", 200, payload)
+}
+
+func (o *GetTimestampInfoOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/info][%d] getTimestampInfoOK %s", 200, payload)
+}
+
+func (o *GetTimestampInfoOK) GetPayload() *models.TimestampInfo {
+	return o.Payload
+}
+
+func (o *GetTimestampInfoOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TimestampInfo)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampInfoDefault creates a GetTimestampInfoDefault with default headers values
+func NewGetTimestampInfoDefault(code int) *GetTimestampInfoDefault {
+	return &GetTimestampInfoDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetTimestampInfoDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetTimestampInfoDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp info default response has a 2xx status code
+func (o *GetTimestampInfoDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get timestamp info default response has a 3xx status code
+func (o *GetTimestampInfoDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get timestamp info default response has a 4xx status code
+func (o *GetTimestampInfoDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get timestamp info default response has a 5xx status code
+func (o *GetTimestampInfoDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get timestamp info default response a status code equal to that given
+func (o *GetTimestampInfoDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get timestamp info default response
+func (o *GetTimestampInfoDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetTimestampInfoDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/info][%d] getTimestampInfo default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampInfoDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/info][%d] getTimestampInfo default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampInfoDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampInfoDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetTimestampLinksParams creates a new GetTimestampLinksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetTimestampLinksParams() *GetTimestampLinksParams {
+	return &GetTimestampLinksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetTimestampLinksParamsWithTimeout creates a new GetTimestampLinksParams object
+// with the ability to set a timeout on a request.
+func NewGetTimestampLinksParamsWithTimeout(timeout time.Duration) *GetTimestampLinksParams {
+	return &GetTimestampLinksParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetTimestampLinksParamsWithContext creates a new GetTimestampLinksParams object
+// with the ability to set a context for a request.
+func NewGetTimestampLinksParamsWithContext(ctx context.Context) *GetTimestampLinksParams {
+	return &GetTimestampLinksParams{
+		Context: ctx,
+	}
+}
+
+// NewGetTimestampLinksParamsWithHTTPClient creates a new GetTimestampLinksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetTimestampLinksParamsWithHTTPClient(client *http.Client) *GetTimestampLinksParams {
+	return &GetTimestampLinksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetTimestampLinksParams contains all the parameters to send to the API endpoint
+
+	for the get timestamp links operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetTimestampLinksParams struct {
+
+	/* End.
+
+	   The index after the last entry, defaults to the length of the chain
+
+	   Format: int64
+	*/
+	End *int64
+
+	/* Start.
+
+	   The index of the first entry
+
+	   Format: int64
+	*/
+	Start *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get timestamp links params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampLinksParams) WithDefaults() *GetTimestampLinksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get timestamp links params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampLinksParams) SetDefaults() {
+	var (
+		startDefault = int64(0)
+	)
+
+	val := GetTimestampLinksParams{
+		Start: &startDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get timestamp links params
+func (o *GetTimestampLinksParams) WithTimeout(timeout time.Duration) *GetTimestampLinksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get timestamp links params
+func (o *GetTimestampLinksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get timestamp links params
+func (o *GetTimestampLinksParams) WithContext(ctx context.Context) *GetTimestampLinksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get timestamp links params
+func (o *GetTimestampLinksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get timestamp links params
+func (o *GetTimestampLinksParams) WithHTTPClient(client *http.Client) *GetTimestampLinksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get timestamp links params
+func (o *GetTimestampLinksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEnd adds the end to the get timestamp links params
+func (o *GetTimestampLinksParams) WithEnd(end *int64) *GetTimestampLinksParams {
+	o.SetEnd(end)
+	return o
+}
+
+// SetEnd adds the end to the get timestamp links params
+func (o *GetTimestampLinksParams) SetEnd(end *int64) {
+	o.End = end
+}
+
+// WithStart adds the start to the get timestamp links params
+func (o *GetTimestampLinksParams) WithStart(start *int64) *GetTimestampLinksParams {
+	o.SetStart(start)
+	return o
+}
+
+// SetStart adds the start to the get timestamp links params
+func (o *GetTimestampLinksParams) SetStart(start *int64) {
+	o.Start = start
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetTimestampLinksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.End != nil {
+
+		// query param end
+		var qrEnd int64
+
+		if o.End != nil {
+			qrEnd = *o.End
+		}
+		qEnd := swag.FormatInt64(qrEnd)
+		if qEnd != "" {
+
+			if err := r.SetQueryParam("end", qEnd); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Start != nil {
+
+		// query param start
+		var qrStart int64
+
+		if o.Start != nil {
+			qrStart = *o.Start
+		}
+		qStart := swag.FormatInt64(qrStart)
+		if qStart != "" {
+
+			if err := r.SetQueryParam("start", qStart); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampLinksReader is a Reader for the GetTimestampLinks structure.
+type GetTimestampLinksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetTimestampLinksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetTimestampLinksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetTimestampLinksBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewGetTimestampLinksNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetTimestampLinksDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetTimestampLinksOK creates a GetTimestampLinksOK with default headers values
+func NewGetTimestampLinksOK() *GetTimestampLinksOK {
+	return &GetTimestampLinksOK{}
+}
+
+/*
+GetTimestampLinksOK describes a response with status code 200, with default header values.
+
+The requested entries of the link chain
+*/
+type GetTimestampLinksOK struct {
+	Payload *models.LinkChain
+}
+
+// IsSuccess returns true when this get timestamp links o k response has a 2xx status code
+func (o *GetTimestampLinksOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get timestamp links o k response has a 3xx status code
+func (o *GetTimestampLinksOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp links o k response has a 4xx status code
+func (o *GetTimestampLinksOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp links o k response has a 5xx status code
+func (o *GetTimestampLinksOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp links o k response a status code equal to that given
+func (o *GetTimestampLinksOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get timestamp links o k response
+func (o *GetTimestampLinksOK) Code() int {
+	return 200
+}
+
+func (o *GetTimestampLinksOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksOK %s", 200, payload)
+}
+
+func (o *GetTimestampLinksOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksOK %s", 200, payload)
+}
+
+func (o *GetTimestampLinksOK) GetPayload() *models.LinkChain {
+	return o.Payload
+}
+
+func (o *GetTimestampLinksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.LinkChain)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampLinksBadRequest creates a GetTimestampLinksBadRequest with default headers values
+func NewGetTimestampLinksBadRequest() *GetTimestampLinksBadRequest {
+	return &GetTimestampLinksBadRequest{}
+}
+
+/*
+GetTimestampLinksBadRequest describes a response with status code 400, with default header values.
+
+The content supplied to the server was invalid
+*/
+type GetTimestampLinksBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp links bad request response has a 2xx status code
+func (o *GetTimestampLinksBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get timestamp links bad request response has a 3xx status code
+func (o *GetTimestampLinksBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp links bad request response has a 4xx status code
+func (o *GetTimestampLinksBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get timestamp links bad request response has a 5xx status code
+func (o *GetTimestampLinksBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp links bad request response a status code equal to that given
+func (o *GetTimestampLinksBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get timestamp links bad request response
+func (o *GetTimestampLinksBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetTimestampLinksBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksBadRequest %s", 400, payload)
+}
+
+func (o *GetTimestampLinksBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksBadRequest %s", 400, payload)
+}
+
+func (o *GetTimestampLinksBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampLinksBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampLinksNotImplemented creates a GetTimestampLinksNotImplemented with default headers values
+func NewGetTimestampLinksNotImplemented() *GetTimestampLinksNotImplemented {
+	return &GetTimestampLinksNotImplemented{}
+}
+
+/*
+GetTimestampLinksNotImplemented describes a response with status code 501, with default header values.
+
+The content requested is not implemented
+*/
+type GetTimestampLinksNotImplemented struct {
+}
+
+// IsSuccess returns true when this get timestamp links not implemented response has a 2xx status code
+func (o *GetTimestampLinksNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get timestamp links not implemented response has a 3xx status code
+func (o *GetTimestampLinksNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp links not implemented response has a 4xx status code
+func (o *GetTimestampLinksNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp links not implemented response has a 5xx status code
+func (o *GetTimestampLinksNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get timestamp links not implemented response a status code equal to that given
+func (o *GetTimestampLinksNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+// Code gets the status code for the get timestamp links not implemented response
+func (o *GetTimestampLinksNotImplemented) Code() int {
+	return 501
+}
+
+func (o *GetTimestampLinksNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksNotImplemented", 501)
+}
+
+func (o *GetTimestampLinksNotImplemented) String() string {
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinksNotImplemented", 501)
+}
+
+func (o *GetTimestampLinksNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetTimestampLinksDefault creates a GetTimestampLinksDefault with default headers values
+func NewGetTimestampLinksDefault(code int) *GetTimestampLinksDefault {
+	return &GetTimestampLinksDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetTimestampLinksDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetTimestampLinksDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp links default response has a 2xx status code
+func (o *GetTimestampLinksDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get timestamp links default response has a 3xx status code
+func (o *GetTimestampLinksDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get timestamp links default response has a 4xx status code
+func (o *GetTimestampLinksDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get timestamp links default response has a 5xx status code
+func (o *GetTimestampLinksDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get timestamp links default response a status code equal to that given
+func (o *GetTimestampLinksDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get timestamp links default response
+func (o *GetTimestampLinksDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetTimestampLinksDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinks default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampLinksDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/links][%d] getTimestampLinks default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampLinksDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampLinksDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetTimestampResponseForArtifactParams creates a new GetTimestampResponseForArtifactParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetTimestampResponseForArtifactParams() *GetTimestampResponseForArtifactParams {
+	return &GetTimestampResponseForArtifactParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetTimestampResponseForArtifactParamsWithTimeout creates a new GetTimestampResponseForArtifactParams object
+// with the ability to set a timeout on a request.
+func NewGetTimestampResponseForArtifactParamsWithTimeout(timeout time.Duration) *GetTimestampResponseForArtifactParams {
+	return &GetTimestampResponseForArtifactParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetTimestampResponseForArtifactParamsWithContext creates a new GetTimestampResponseForArtifactParams object
+// with the ability to set a context for a request.
+func NewGetTimestampResponseForArtifactParamsWithContext(ctx context.Context) *GetTimestampResponseForArtifactParams {
+	return &GetTimestampResponseForArtifactParams{
+		Context: ctx,
+	}
+}
+
+// NewGetTimestampResponseForArtifactParamsWithHTTPClient creates a new GetTimestampResponseForArtifactParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetTimestampResponseForArtifactParamsWithHTTPClient(client *http.Client) *GetTimestampResponseForArtifactParams {
+	return &GetTimestampResponseForArtifactParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetTimestampResponseForArtifactParams contains all the parameters to send to the API endpoint
+
+	for the get timestamp response for artifact operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetTimestampResponseForArtifactParams struct {
+
+	// Artifact.
+	//
+	// Format: binary
+	Artifact io.ReadCloser
+
+	/* Certificates.
+
+	   Whether to include the timestamping certificate chain in the response
+	*/
+	Certificates *bool
+
+	/* HashAlgorithm.
+
+	   Hash algorithm used to hash the artifact
+
+	   Default: "sha256"
+	*/
+	HashAlgorithm *string
+
+	/* TsaPolicyOID.
+
+	   The TSA policy requested for the timestamp
+	*/
+	TsaPolicyOID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get timestamp response for artifact params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampResponseForArtifactParams) WithDefaults() *GetTimestampResponseForArtifactParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get timestamp response for artifact params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampResponseForArtifactParams) SetDefaults() {
+	var (
+		certificatesDefault = bool(false)
+
+		hashAlgorithmDefault = string("sha256")
+	)
+
+	val := GetTimestampResponseForArtifactParams{
+		Certificates:  &certificatesDefault,
+		HashAlgorithm: &hashAlgorithmDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithTimeout(timeout time.Duration) *GetTimestampResponseForArtifactParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithContext(ctx context.Context) *GetTimestampResponseForArtifactParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithHTTPClient(client *http.Client) *GetTimestampResponseForArtifactParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArtifact adds the artifact to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithArtifact(artifact io.ReadCloser) *GetTimestampResponseForArtifactParams {
+	o.SetArtifact(artifact)
+	return o
+}
+
+// SetArtifact adds the artifact to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetArtifact(artifact io.ReadCloser) {
+	o.Artifact = artifact
+}
+
+// WithCertificates adds the certificates to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithCertificates(certificates *bool) *GetTimestampResponseForArtifactParams {
+	o.SetCertificates(certificates)
+	return o
+}
+
+// SetCertificates adds the certificates to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetCertificates(certificates *bool) {
+	o.Certificates = certificates
+}
+
+// WithHashAlgorithm adds the hashAlgorithm to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithHashAlgorithm(hashAlgorithm *string) *GetTimestampResponseForArtifactParams {
+	o.SetHashAlgorithm(hashAlgorithm)
+	return o
+}
+
+// SetHashAlgorithm adds the hashAlgorithm to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetHashAlgorithm(hashAlgorithm *string) {
+	o.HashAlgorithm = hashAlgorithm
+}
+
+// WithTsaPolicyOID adds the tsaPolicyOID to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) WithTsaPolicyOID(tsaPolicyOID *string) *GetTimestampResponseForArtifactParams {
+	o.SetTsaPolicyOID(tsaPolicyOID)
+	return o
+}
+
+// SetTsaPolicyOID adds the tsaPolicyOId to the get timestamp response for artifact params
+func (o *GetTimestampResponseForArtifactParams) SetTsaPolicyOID(tsaPolicyOID *string) {
+	o.TsaPolicyOID = tsaPolicyOID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetTimestampResponseForArtifactParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Artifact != nil {
+		if err := r.SetBodyParam(o.Artifact); err != nil {
+			return err
+		}
+	}
+
+	if o.Certificates != nil {
+
+		// query param certificates
+		var qrCertificates bool
+
+		if o.Certificates != nil {
+			qrCertificates = *o.Certificates
+		}
+		qCertificates := swag.FormatBool(qrCertificates)
+		if qCertificates != "" {
+
+			if err := r.SetQueryParam("certificates", qCertificates); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.HashAlgorithm != nil {
+
+		// query param hashAlgorithm
+		var qrHashAlgorithm string
+
+		if o.HashAlgorithm != nil {
+			qrHashAlgorithm = *o.HashAlgorithm
+		}
+		qHashAlgorithm := qrHashAlgorithm
+		if qHashAlgorithm != "" {
+
+			if err := r.SetQueryParam("hashAlgorithm", qHashAlgorithm); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TsaPolicyOID != nil {
+
+		// query param tsaPolicyOID
+		var qrTsaPolicyOID string
+
+		if o.TsaPolicyOID != nil {
+			qrTsaPolicyOID = *o.TsaPolicyOID
+		}
+		qTsaPolicyOID := qrTsaPolicyOID
+		if qTsaPolicyOID != "" {
+
+			if err := r.SetQueryParam("tsaPolicyOID", qTsaPolicyOID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
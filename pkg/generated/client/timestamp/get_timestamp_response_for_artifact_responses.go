@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampResponseForArtifactReader is a Reader for the GetTimestampResponseForArtifact structure.
+type GetTimestampResponseForArtifactReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetTimestampResponseForArtifactReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewGetTimestampResponseForArtifactCreated(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetTimestampResponseForArtifactBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewGetTimestampResponseForArtifactRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewGetTimestampResponseForArtifactNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetTimestampResponseForArtifactDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetTimestampResponseForArtifactCreated creates a GetTimestampResponseForArtifactCreated with default headers values
+func NewGetTimestampResponseForArtifactCreated(writer io.Writer) *GetTimestampResponseForArtifactCreated {
+	return &GetTimestampResponseForArtifactCreated{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetTimestampResponseForArtifactCreated describes a response with status code 201, with default header values.
+
+Returns a timestamp response over the digest of the artifact
+*/
+type GetTimestampResponseForArtifactCreated struct {
+
+	/* The digest of the artifact, as <algorithm>:<hex digest>
+	 */
+	XArtifactDigest string
+
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get timestamp response for artifact created response has a 2xx status code
+func (o *GetTimestampResponseForArtifactCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get timestamp response for artifact created response has a 3xx status code
+func (o *GetTimestampResponseForArtifactCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp response for artifact created response has a 4xx status code
+func (o *GetTimestampResponseForArtifactCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp response for artifact created response has a 5xx status code
+func (o *GetTimestampResponseForArtifactCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp response for artifact created response a status code equal to that given
+func (o *GetTimestampResponseForArtifactCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the get timestamp response for artifact created response
+func (o *GetTimestampResponseForArtifactCreated) Code() int {
+	return 201
+}
+
+func (o *GetTimestampResponseForArtifactCreated) Error() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactCreated", 201)
+}
+
+func (o *GetTimestampResponseForArtifactCreated) String() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactCreated", 201)
+}
+
+func (o *GetTimestampResponseForArtifactCreated) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetTimestampResponseForArtifactCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Artifact-Digest
+	hdrXArtifactDigest := response.GetHeader("X-Artifact-Digest")
+
+	if hdrXArtifactDigest != "" {
+		o.XArtifactDigest = hdrXArtifactDigest
+	}
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampResponseForArtifactBadRequest creates a GetTimestampResponseForArtifactBadRequest with default headers values
+func NewGetTimestampResponseForArtifactBadRequest() *GetTimestampResponseForArtifactBadRequest {
+	return &GetTimestampResponseForArtifactBadRequest{}
+}
+
+/*
+GetTimestampResponseForArtifactBadRequest describes a response with status code 400, with default header values.
+
+The content supplied to the server was invalid
+*/
+type GetTimestampResponseForArtifactBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp response for artifact bad request response has a 2xx status code
+func (o *GetTimestampResponseForArtifactBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get timestamp response for artifact bad request response has a 3xx status code
+func (o *GetTimestampResponseForArtifactBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp response for artifact bad request response has a 4xx status code
+func (o *GetTimestampResponseForArtifactBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get timestamp response for artifact bad request response has a 5xx status code
+func (o *GetTimestampResponseForArtifactBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp response for artifact bad request response a status code equal to that given
+func (o *GetTimestampResponseForArtifactBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get timestamp response for artifact bad request response
+func (o *GetTimestampResponseForArtifactBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetTimestampResponseForArtifactBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactBadRequest %s", 400, payload)
+}
+
+func (o *GetTimestampResponseForArtifactBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactBadRequest %s", 400, payload)
+}
+
+func (o *GetTimestampResponseForArtifactBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampResponseForArtifactBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampResponseForArtifactRequestEntityTooLarge creates a GetTimestampResponseForArtifactRequestEntityTooLarge with default headers values
+func NewGetTimestampResponseForArtifactRequestEntityTooLarge() *GetTimestampResponseForArtifactRequestEntityTooLarge {
+	return &GetTimestampResponseForArtifactRequestEntityTooLarge{}
+}
+
+/*
+GetTimestampResponseForArtifactRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+The content supplied to the server exceeds the maximum size
+*/
+type GetTimestampResponseForArtifactRequestEntityTooLarge struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp response for artifact request entity too large response has a 2xx status code
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get timestamp response for artifact request entity too large response has a 3xx status code
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp response for artifact request entity too large response has a 4xx status code
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get timestamp response for artifact request entity too large response has a 5xx status code
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp response for artifact request entity too large response a status code equal to that given
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the get timestamp response for artifact request entity too large response
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampResponseForArtifactNotImplemented creates a GetTimestampResponseForArtifactNotImplemented with default headers values
+func NewGetTimestampResponseForArtifactNotImplemented() *GetTimestampResponseForArtifactNotImplemented {
+	return &GetTimestampResponseForArtifactNotImplemented{}
+}
+
+/*
+GetTimestampResponseForArtifactNotImplemented describes a response with status code 501, with default header values.
+
+The content requested is not implemented
+*/
+type GetTimestampResponseForArtifactNotImplemented struct {
+}
+
+// IsSuccess returns true when this get timestamp response for artifact not implemented response has a 2xx status code
+func (o *GetTimestampResponseForArtifactNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get timestamp response for artifact not implemented response has a 3xx status code
+func (o *GetTimestampResponseForArtifactNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp response for artifact not implemented response has a 4xx status code
+func (o *GetTimestampResponseForArtifactNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp response for artifact not implemented response has a 5xx status code
+func (o *GetTimestampResponseForArtifactNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get timestamp response for artifact not implemented response a status code equal to that given
+func (o *GetTimestampResponseForArtifactNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+// Code gets the status code for the get timestamp response for artifact not implemented response
+func (o *GetTimestampResponseForArtifactNotImplemented) Code() int {
+	return 501
+}
+
+func (o *GetTimestampResponseForArtifactNotImplemented) Error() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactNotImplemented", 501)
+}
+
+func (o *GetTimestampResponseForArtifactNotImplemented) String() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifactNotImplemented", 501)
+}
+
+func (o *GetTimestampResponseForArtifactNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetTimestampResponseForArtifactDefault creates a GetTimestampResponseForArtifactDefault with default headers values
+func NewGetTimestampResponseForArtifactDefault(code int) *GetTimestampResponseForArtifactDefault {
+	return &GetTimestampResponseForArtifactDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetTimestampResponseForArtifactDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetTimestampResponseForArtifactDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp response for artifact default response has a 2xx status code
+func (o *GetTimestampResponseForArtifactDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get timestamp response for artifact default response has a 3xx status code
+func (o *GetTimestampResponseForArtifactDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get timestamp response for artifact default response has a 4xx status code
+func (o *GetTimestampResponseForArtifactDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get timestamp response for artifact default response has a 5xx status code
+func (o *GetTimestampResponseForArtifactDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get timestamp response for artifact default response a status code equal to that given
+func (o *GetTimestampResponseForArtifactDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get timestamp response for artifact default response
+func (o *GetTimestampResponseForArtifactDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetTimestampResponseForArtifactDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifact default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampResponseForArtifactDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/artifact][%d] getTimestampResponseForArtifact default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampResponseForArtifactDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampResponseForArtifactDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
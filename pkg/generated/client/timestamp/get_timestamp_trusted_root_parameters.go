@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetTimestampTrustedRootParams creates a new GetTimestampTrustedRootParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetTimestampTrustedRootParams() *GetTimestampTrustedRootParams {
+	return &GetTimestampTrustedRootParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetTimestampTrustedRootParamsWithTimeout creates a new GetTimestampTrustedRootParams object
+// with the ability to set a timeout on a request.
+func NewGetTimestampTrustedRootParamsWithTimeout(timeout time.Duration) *GetTimestampTrustedRootParams {
+	return &GetTimestampTrustedRootParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetTimestampTrustedRootParamsWithContext creates a new GetTimestampTrustedRootParams object
+// with the ability to set a context for a request.
+func NewGetTimestampTrustedRootParamsWithContext(ctx context.Context) *GetTimestampTrustedRootParams {
+	return &GetTimestampTrustedRootParams{
+		Context: ctx,
+	}
+}
+
+// NewGetTimestampTrustedRootParamsWithHTTPClient creates a new GetTimestampTrustedRootParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetTimestampTrustedRootParamsWithHTTPClient(client *http.Client) *GetTimestampTrustedRootParams {
+	return &GetTimestampTrustedRootParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetTimestampTrustedRootParams contains all the parameters to send to the API endpoint
+
+	for the get timestamp trusted root operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetTimestampTrustedRootParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get timestamp trusted root params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampTrustedRootParams) WithDefaults() *GetTimestampTrustedRootParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get timestamp trusted root params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetTimestampTrustedRootParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) WithTimeout(timeout time.Duration) *GetTimestampTrustedRootParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) WithContext(ctx context.Context) *GetTimestampTrustedRootParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) WithHTTPClient(client *http.Client) *GetTimestampTrustedRootParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get timestamp trusted root params
+func (o *GetTimestampTrustedRootParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetTimestampTrustedRootParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
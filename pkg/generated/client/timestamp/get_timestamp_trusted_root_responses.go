@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampTrustedRootReader is a Reader for the GetTimestampTrustedRoot structure.
+type GetTimestampTrustedRootReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetTimestampTrustedRootReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetTimestampTrustedRootOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetTimestampTrustedRootDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetTimestampTrustedRootOK creates a GetTimestampTrustedRootOK with default headers values
+func NewGetTimestampTrustedRootOK() *GetTimestampTrustedRootOK {
+	return &GetTimestampTrustedRootOK{}
+}
+
+/*
+GetTimestampTrustedRootOK describes a response with status code 200, with default header values.
+
+The TrustedRoot fragment describing the timestamp authority
+*/
+type GetTimestampTrustedRootOK struct {
+	Payload interface{}
+}
+
+// IsSuccess returns true when this get timestamp trusted root o k response has a 2xx status code
+func (o *GetTimestampTrustedRootOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get timestamp trusted root o k response has a 3xx status code
+func (o *GetTimestampTrustedRootOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get timestamp trusted root o k response has a 4xx status code
+func (o *GetTimestampTrustedRootOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get timestamp trusted root o k response has a 5xx status code
+func (o *GetTimestampTrustedRootOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get timestamp trusted root o k response a status code equal to that given
+func (o *GetTimestampTrustedRootOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get timestamp trusted root o k response
+func (o *GetTimestampTrustedRootOK) Code() int {
+	return 200
+}
+
+func (o *GetTimestampTrustedRootOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/trustedroot][%d] getTimestampTrustedRootOK %s", 200, payload)
+}
+
+func (o *GetTimestampTrustedRootOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/trustedroot][%d] getTimestampTrustedRootOK %s", 200, payload)
+}
+
+func (o *GetTimestampTrustedRootOK) GetPayload() interface{} {
+	return o.Payload
+}
+
+func (o *GetTimestampTrustedRootOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetTimestampTrustedRootDefault creates a GetTimestampTrustedRootDefault with default headers values
+func NewGetTimestampTrustedRootDefault(code int) *GetTimestampTrustedRootDefault {
+	return &GetTimestampTrustedRootDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetTimestampTrustedRootDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetTimestampTrustedRootDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get timestamp trusted root default response has a 2xx status code
+func (o *GetTimestampTrustedRootDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get timestamp trusted root default response has a 3xx status code
+func (o *GetTimestampTrustedRootDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get timestamp trusted root default response has a 4xx status code
+func (o *GetTimestampTrustedRootDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get timestamp trusted root default response has a 5xx status code
+func (o *GetTimestampTrustedRootDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get timestamp trusted root default response a status code equal to that given
+func (o *GetTimestampTrustedRootDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get timestamp trusted root default response
+func (o *GetTimestampTrustedRootDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetTimestampTrustedRootDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/trustedroot][%d] getTimestampTrustedRoot default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampTrustedRootDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api/v1/timestamp/trustedroot][%d] getTimestampTrustedRoot default %s", o._statusCode, payload)
+}
+
+func (o *GetTimestampTrustedRootDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetTimestampTrustedRootDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
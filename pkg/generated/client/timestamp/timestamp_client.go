@@ -88,11 +88,21 @@ func WithContentTypeApplicationJSON(r *runtime.ClientOperation) {
 	r.ConsumesMediaTypes = []string{"application/json"}
 }
 
+// WithContentTypeApplicationOctetStream sets the Content-Type header to "application/octet-stream".
+func WithContentTypeApplicationOctetStream(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/octet-stream"}
+}
+
 // WithContentTypeApplicationTimestampQuery sets the Content-Type header to "application/timestamp-query".
 func WithContentTypeApplicationTimestampQuery(r *runtime.ClientOperation) {
 	r.ConsumesMediaTypes = []string{"application/timestamp-query"}
 }
 
+// WithContentTypeMultipartFormData sets the Content-Type header to "multipart/form-data".
+func WithContentTypeMultipartFormData(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"multipart/form-data"}
+}
+
 // WithAccept allows the client to force the Accept header
 // to negotiate a specific Producer from the server.
 //
@@ -108,11 +118,26 @@ func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
 	r.ProducesMediaTypes = []string{"application/json"}
 }
 
+// WithAcceptApplicationOctetStream sets the Accept header to "application/octet-stream".
+func WithAcceptApplicationOctetStream(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/octet-stream"}
+}
+
 // WithAcceptApplicationPemCertificateChain sets the Accept header to "application/pem-certificate-chain".
 func WithAcceptApplicationPemCertificateChain(r *runtime.ClientOperation) {
 	r.ProducesMediaTypes = []string{"application/pem-certificate-chain"}
 }
 
+// WithAcceptApplicationPkcs7Mime sets the Accept header to "application/pkcs7-mime".
+func WithAcceptApplicationPkcs7Mime(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/pkcs7-mime"}
+}
+
+// WithAcceptApplicationPkixCert sets the Accept header to "application/pkix-cert".
+func WithAcceptApplicationPkixCert(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/pkix-cert"}
+}
+
 // WithAcceptApplicationTimestampReply sets the Accept header to "application/timestamp-reply".
 func WithAcceptApplicationTimestampReply(r *runtime.ClientOperation) {
 	r.ProducesMediaTypes = []string{"application/timestamp-reply"}
@@ -120,17 +145,68 @@ func WithAcceptApplicationTimestampReply(r *runtime.ClientOperation) {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	GetAuthenticodeTimestampResponse(params *GetAuthenticodeTimestampResponseParams, writer io.Writer, opts ...ClientOption) (*GetAuthenticodeTimestampResponseOK, error)
+
 	GetTimestampCertChain(params *GetTimestampCertChainParams, opts ...ClientOption) (*GetTimestampCertChainOK, error)
 
+	GetTimestampInfo(params *GetTimestampInfoParams, opts ...ClientOption) (*GetTimestampInfoOK, error)
+
+	GetTimestampLinks(params *GetTimestampLinksParams, opts ...ClientOption) (*GetTimestampLinksOK, error)
+
 	GetTimestampResponse(params *GetTimestampResponseParams, writer io.Writer, opts ...ClientOption) (*GetTimestampResponseCreated, error)
 
+	GetTimestampResponseForArtifact(params *GetTimestampResponseForArtifactParams, writer io.Writer, opts ...ClientOption) (*GetTimestampResponseForArtifactCreated, error)
+
+	GetTimestampTrustedRoot(params *GetTimestampTrustedRootParams, opts ...ClientOption) (*GetTimestampTrustedRootOK, error)
+
+	VerifyTimestampResponse(params *VerifyTimestampResponseParams, opts ...ClientOption) (*VerifyTimestampResponseOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+GetAuthenticodeTimestampResponse generates a microsoft authenticode legacy timestamp
+
+Implements the Authenticode legacy timestamp protocol used by signtool /t and older Windows signing tools. The request is a base64 encoded TimeStampRequest holding the signature to countersign, and the response is a base64 encoded PKCS#7 SignedData countersignature, signed with the same certificate chain as RFC 3161 timestamps.
+*/
+func (a *Client) GetAuthenticodeTimestampResponse(params *GetAuthenticodeTimestampResponseParams, writer io.Writer, opts ...ClientOption) (*GetAuthenticodeTimestampResponseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAuthenticodeTimestampResponseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAuthenticodeTimestampResponse",
+		Method:             "POST",
+		PathPattern:        "/api/v1/timestamp/authenticode",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/octet-stream"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAuthenticodeTimestampResponseReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAuthenticodeTimestampResponseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAuthenticodeTimestampResponseDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetTimestampCertChain retrieves the certificate chain for timestamping that can be used to validate trusted timestamps
 
-Returns the certificate chain for timestamping that can be used to validate trusted timestamps
+Returns the certificate chain for timestamping that can be used to validate trusted timestamps. The format is selected with the Accept header: a PEM encoded chain (the default), a certs-only PKCS#7 SignedData bundle (application/pkcs7-mime), the DER encoded leaf certificate only (application/pkix-cert), or a CertificateChain JSON document describing each certificate (application/json).
 */
 func (a *Client) GetTimestampCertChain(params *GetTimestampCertChainParams, opts ...ClientOption) (*GetTimestampCertChainOK, error) {
 	// TODO: Validate the params before sending
@@ -141,7 +217,7 @@ func (a *Client) GetTimestampCertChain(params *GetTimestampCertChainParams, opts
 		ID:                 "getTimestampCertChain",
 		Method:             "GET",
 		PathPattern:        "/api/v1/timestamp/certchain",
-		ProducesMediaTypes: []string{"application/pem-certificate-chain"},
+		ProducesMediaTypes: []string{"application/pem-certificate-chain", "application/pkcs7-mime", "application/pkix-cert", "application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -166,6 +242,84 @@ func (a *Client) GetTimestampCertChain(params *GetTimestampCertChainParams, opts
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetTimestampInfo describes the capabilities of the timestamp authority
+
+Returns the supported hash algorithms, policies, accuracy and certificate chain of the timestamp authority, along with the server version
+*/
+func (a *Client) GetTimestampInfo(params *GetTimestampInfoParams, opts ...ClientOption) (*GetTimestampInfoOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTimestampInfoParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getTimestampInfo",
+		Method:             "GET",
+		PathPattern:        "/api/v1/timestamp/info",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTimestampInfoReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTimestampInfoOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTimestampInfoDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetTimestampLinks gets the link chain of linked timestamps
+
+Returns the entries of the hash chain linking issued timestamps, from start up to but excluding end, along with the current length and head of the chain. Each timestamp carries its index in the chain and the head before it, so that it can be verified to lead to a later head. At most 1000 entries are returned. Disabled unless linking is enabled in the server configuration.
+*/
+func (a *Client) GetTimestampLinks(params *GetTimestampLinksParams, opts ...ClientOption) (*GetTimestampLinksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTimestampLinksParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getTimestampLinks",
+		Method:             "GET",
+		PathPattern:        "/api/v1/timestamp/links",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTimestampLinksReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTimestampLinksOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTimestampLinksDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetTimestampResponse generates a new timestamp response and creates a new log entry for the timestamp in the transparency log
 */
@@ -203,6 +357,123 @@ func (a *Client) GetTimestampResponse(params *GetTimestampResponseParams, writer
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetTimestampResponseForArtifact hashes an uploaded artifact and generates a timestamp response over its digest
+
+For clients that cannot build a TimeStampReq, the raw artifact is streamed in the request body and hashed by the server, which returns a timestamp response over the digest. The digest is returned in the X-Artifact-Digest header as <algorithm>:<hex digest>. The artifact is not retained. Disabled unless artifact hashing is enabled in the server configuration.
+*/
+func (a *Client) GetTimestampResponseForArtifact(params *GetTimestampResponseForArtifactParams, writer io.Writer, opts ...ClientOption) (*GetTimestampResponseForArtifactCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTimestampResponseForArtifactParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getTimestampResponseForArtifact",
+		Method:             "POST",
+		PathPattern:        "/api/v1/timestamp/artifact",
+		ProducesMediaTypes: []string{"application/timestamp-reply"},
+		ConsumesMediaTypes: []string{"application/octet-stream"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTimestampResponseForArtifactReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTimestampResponseForArtifactCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTimestampResponseForArtifactDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetTimestampTrustedRoot describes the timestamp authority as sigstore trusted root entries
+
+Returns a Sigstore TrustedRoot JSON document holding only timestampAuthorities entries, one for each certificate chain used by the timestamp authority, including chains used before a rotation
+*/
+func (a *Client) GetTimestampTrustedRoot(params *GetTimestampTrustedRootParams, opts ...ClientOption) (*GetTimestampTrustedRootOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTimestampTrustedRootParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getTimestampTrustedRoot",
+		Method:             "GET",
+		PathPattern:        "/api/v1/timestamp/trustedroot",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTimestampTrustedRootReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTimestampTrustedRootOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTimestampTrustedRootDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+VerifyTimestampResponse verifies a timestamp response
+
+For clients that cannot verify CMS signatures and X.509 certificate chains themselves, the server verifies a timestamp response over an artifact, or over its digest, against each certificate chain used by the timestamp authority, including chains used before a rotation, and against a chain supplied by the caller. Returns the outcome of each check and the decoded timestamp. Uploading the artifact is disabled unless artifact hashing is enabled in the server configuration.
+*/
+func (a *Client) VerifyTimestampResponse(params *VerifyTimestampResponseParams, opts ...ClientOption) (*VerifyTimestampResponseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerifyTimestampResponseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "verifyTimestampResponse",
+		Method:             "POST",
+		PathPattern:        "/api/v1/timestamp/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerifyTimestampResponseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerifyTimestampResponseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*VerifyTimestampResponseDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
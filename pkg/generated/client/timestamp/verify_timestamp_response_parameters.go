@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewVerifyTimestampResponseParams creates a new VerifyTimestampResponseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewVerifyTimestampResponseParams() *VerifyTimestampResponseParams {
+	return &VerifyTimestampResponseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewVerifyTimestampResponseParamsWithTimeout creates a new VerifyTimestampResponseParams object
+// with the ability to set a timeout on a request.
+func NewVerifyTimestampResponseParamsWithTimeout(timeout time.Duration) *VerifyTimestampResponseParams {
+	return &VerifyTimestampResponseParams{
+		timeout: timeout,
+	}
+}
+
+// NewVerifyTimestampResponseParamsWithContext creates a new VerifyTimestampResponseParams object
+// with the ability to set a context for a request.
+func NewVerifyTimestampResponseParamsWithContext(ctx context.Context) *VerifyTimestampResponseParams {
+	return &VerifyTimestampResponseParams{
+		Context: ctx,
+	}
+}
+
+// NewVerifyTimestampResponseParamsWithHTTPClient creates a new VerifyTimestampResponseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewVerifyTimestampResponseParamsWithHTTPClient(client *http.Client) *VerifyTimestampResponseParams {
+	return &VerifyTimestampResponseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+VerifyTimestampResponseParams contains all the parameters to send to the API endpoint
+
+	for the verify timestamp response operation.
+
+	Typically these are written to a http.Request.
+*/
+type VerifyTimestampResponseParams struct {
+
+	/* Artifact.
+
+	   The timestamped artifact. Either the artifact or its digest must be provided
+	*/
+	Artifact runtime.NamedReadCloser
+
+	/* CertificateChain.
+
+	   A PEM encoded certificate chain to trust in addition to the chains of the timestamp authority, starting with the timestamping certificate and ending with the root
+
+	*/
+	CertificateChain *string
+
+	/* Digest.
+
+	   The digest of the timestamped artifact, as <algorithm>:<hex digest>
+	*/
+	Digest *string
+
+	/* Tsr.
+
+	   The DER or base64 encoded timestamp response
+	*/
+	Tsr runtime.NamedReadCloser
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the verify timestamp response params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *VerifyTimestampResponseParams) WithDefaults() *VerifyTimestampResponseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the verify timestamp response params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *VerifyTimestampResponseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithTimeout(timeout time.Duration) *VerifyTimestampResponseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithContext(ctx context.Context) *VerifyTimestampResponseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithHTTPClient(client *http.Client) *VerifyTimestampResponseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArtifact adds the artifact to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithArtifact(artifact runtime.NamedReadCloser) *VerifyTimestampResponseParams {
+	o.SetArtifact(artifact)
+	return o
+}
+
+// SetArtifact adds the artifact to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetArtifact(artifact runtime.NamedReadCloser) {
+	o.Artifact = artifact
+}
+
+// WithCertificateChain adds the certificateChain to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithCertificateChain(certificateChain *string) *VerifyTimestampResponseParams {
+	o.SetCertificateChain(certificateChain)
+	return o
+}
+
+// SetCertificateChain adds the certificateChain to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetCertificateChain(certificateChain *string) {
+	o.CertificateChain = certificateChain
+}
+
+// WithDigest adds the digest to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithDigest(digest *string) *VerifyTimestampResponseParams {
+	o.SetDigest(digest)
+	return o
+}
+
+// SetDigest adds the digest to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetDigest(digest *string) {
+	o.Digest = digest
+}
+
+// WithTsr adds the tsr to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) WithTsr(tsr runtime.NamedReadCloser) *VerifyTimestampResponseParams {
+	o.SetTsr(tsr)
+	return o
+}
+
+// SetTsr adds the tsr to the verify timestamp response params
+func (o *VerifyTimestampResponseParams) SetTsr(tsr runtime.NamedReadCloser) {
+	o.Tsr = tsr
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *VerifyTimestampResponseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Artifact != nil {
+
+		if o.Artifact != nil {
+			// form file param artifact
+			if err := r.SetFileParam("artifact", o.Artifact); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CertificateChain != nil {
+
+		// form param certificateChain
+		var frCertificateChain string
+		if o.CertificateChain != nil {
+			frCertificateChain = *o.CertificateChain
+		}
+		fCertificateChain := frCertificateChain
+		if fCertificateChain != "" {
+			if err := r.SetFormParam("certificateChain", fCertificateChain); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Digest != nil {
+
+		// form param digest
+		var frDigest string
+		if o.Digest != nil {
+			frDigest = *o.Digest
+		}
+		fDigest := frDigest
+		if fDigest != "" {
+			if err := r.SetFormParam("digest", fDigest); err != nil {
+				return err
+			}
+		}
+	}
+	// form file param tsr
+	if err := r.SetFileParam("tsr", o.Tsr); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// VerifyTimestampResponseReader is a Reader for the VerifyTimestampResponse structure.
+type VerifyTimestampResponseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *VerifyTimestampResponseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewVerifyTimestampResponseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewVerifyTimestampResponseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewVerifyTimestampResponseRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewVerifyTimestampResponseNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewVerifyTimestampResponseDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewVerifyTimestampResponseOK creates a VerifyTimestampResponseOK with default headers values
+func NewVerifyTimestampResponseOK() *VerifyTimestampResponseOK {
+	return &VerifyTimestampResponseOK{}
+}
+
+/*
+VerifyTimestampResponseOK describes a response with status code 200, with default header values.
+
+The verification report, whether or not the timestamp response was verified
+*/
+type VerifyTimestampResponseOK struct {
+	Payload *models.VerificationReport
+}
+
+// IsSuccess returns true when this verify timestamp response o k response has a 2xx status code
+func (o *VerifyTimestampResponseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this verify timestamp response o k response has a 3xx status code
+func (o *VerifyTimestampResponseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify timestamp response o k response has a 4xx status code
+func (o *VerifyTimestampResponseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this verify timestamp response o k response has a 5xx status code
+func (o *VerifyTimestampResponseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify timestamp response o k response a status code equal to that given
+func (o *VerifyTimestampResponseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the verify timestamp response o k response
+func (o *VerifyTimestampResponseOK) Code() int {
+	return 200
+}
+
+func (o *VerifyTimestampResponseOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseOK %s", 200, payload)
+}
+
+func (o *VerifyTimestampResponseOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseOK %s", 200, payload)
+}
+
+func (o *VerifyTimestampResponseOK) GetPayload() *models.VerificationReport {
+	return o.Payload
+}
+
+func (o *VerifyTimestampResponseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VerificationReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifyTimestampResponseBadRequest creates a VerifyTimestampResponseBadRequest with default headers values
+func NewVerifyTimestampResponseBadRequest() *VerifyTimestampResponseBadRequest {
+	return &VerifyTimestampResponseBadRequest{}
+}
+
+/*
+VerifyTimestampResponseBadRequest describes a response with status code 400, with default header values.
+
+The content supplied to the server was invalid
+*/
+type VerifyTimestampResponseBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this verify timestamp response bad request response has a 2xx status code
+func (o *VerifyTimestampResponseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify timestamp response bad request response has a 3xx status code
+func (o *VerifyTimestampResponseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify timestamp response bad request response has a 4xx status code
+func (o *VerifyTimestampResponseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this verify timestamp response bad request response has a 5xx status code
+func (o *VerifyTimestampResponseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify timestamp response bad request response a status code equal to that given
+func (o *VerifyTimestampResponseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the verify timestamp response bad request response
+func (o *VerifyTimestampResponseBadRequest) Code() int {
+	return 400
+}
+
+func (o *VerifyTimestampResponseBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseBadRequest %s", 400, payload)
+}
+
+func (o *VerifyTimestampResponseBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseBadRequest %s", 400, payload)
+}
+
+func (o *VerifyTimestampResponseBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *VerifyTimestampResponseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifyTimestampResponseRequestEntityTooLarge creates a VerifyTimestampResponseRequestEntityTooLarge with default headers values
+func NewVerifyTimestampResponseRequestEntityTooLarge() *VerifyTimestampResponseRequestEntityTooLarge {
+	return &VerifyTimestampResponseRequestEntityTooLarge{}
+}
+
+/*
+VerifyTimestampResponseRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+The content supplied to the server exceeds the maximum size
+*/
+type VerifyTimestampResponseRequestEntityTooLarge struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this verify timestamp response request entity too large response has a 2xx status code
+func (o *VerifyTimestampResponseRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify timestamp response request entity too large response has a 3xx status code
+func (o *VerifyTimestampResponseRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify timestamp response request entity too large response has a 4xx status code
+func (o *VerifyTimestampResponseRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this verify timestamp response request entity too large response has a 5xx status code
+func (o *VerifyTimestampResponseRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify timestamp response request entity too large response a status code equal to that given
+func (o *VerifyTimestampResponseRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the verify timestamp response request entity too large response
+func (o *VerifyTimestampResponseRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *VerifyTimestampResponseRequestEntityTooLarge) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *VerifyTimestampResponseRequestEntityTooLarge) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *VerifyTimestampResponseRequestEntityTooLarge) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *VerifyTimestampResponseRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifyTimestampResponseNotImplemented creates a VerifyTimestampResponseNotImplemented with default headers values
+func NewVerifyTimestampResponseNotImplemented() *VerifyTimestampResponseNotImplemented {
+	return &VerifyTimestampResponseNotImplemented{}
+}
+
+/*
+VerifyTimestampResponseNotImplemented describes a response with status code 501, with default header values.
+
+The content requested is not implemented
+*/
+type VerifyTimestampResponseNotImplemented struct {
+}
+
+// IsSuccess returns true when this verify timestamp response not implemented response has a 2xx status code
+func (o *VerifyTimestampResponseNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this verify timestamp response not implemented response has a 3xx status code
+func (o *VerifyTimestampResponseNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify timestamp response not implemented response has a 4xx status code
+func (o *VerifyTimestampResponseNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this verify timestamp response not implemented response has a 5xx status code
+func (o *VerifyTimestampResponseNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this verify timestamp response not implemented response a status code equal to that given
+func (o *VerifyTimestampResponseNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+// Code gets the status code for the verify timestamp response not implemented response
+func (o *VerifyTimestampResponseNotImplemented) Code() int {
+	return 501
+}
+
+func (o *VerifyTimestampResponseNotImplemented) Error() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseNotImplemented", 501)
+}
+
+func (o *VerifyTimestampResponseNotImplemented) String() string {
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponseNotImplemented", 501)
+}
+
+func (o *VerifyTimestampResponseNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewVerifyTimestampResponseDefault creates a VerifyTimestampResponseDefault with default headers values
+func NewVerifyTimestampResponseDefault(code int) *VerifyTimestampResponseDefault {
+	return &VerifyTimestampResponseDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+VerifyTimestampResponseDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type VerifyTimestampResponseDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this verify timestamp response default response has a 2xx status code
+func (o *VerifyTimestampResponseDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this verify timestamp response default response has a 3xx status code
+func (o *VerifyTimestampResponseDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this verify timestamp response default response has a 4xx status code
+func (o *VerifyTimestampResponseDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this verify timestamp response default response has a 5xx status code
+func (o *VerifyTimestampResponseDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this verify timestamp response default response a status code equal to that given
+func (o *VerifyTimestampResponseDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the verify timestamp response default response
+func (o *VerifyTimestampResponseDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *VerifyTimestampResponseDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponse default %s", o._statusCode, payload)
+}
+
+func (o *VerifyTimestampResponseDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api/v1/timestamp/verify][%d] verifyTimestampResponse default %s", o._statusCode, payload)
+}
+
+func (o *VerifyTimestampResponseDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *VerifyTimestampResponseDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CertificateChain certificate chain
+//
+// swagger:model CertificateChain
+type CertificateChain struct {
+
+	// Timestamping certificate chain, starting with the leaf certificate and ending with the root
+	// Required: true
+	Certificates []*CertificateInfo `json:"certificates"`
+}
+
+// Validate validates this certificate chain
+func (m *CertificateChain) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCertificates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CertificateChain) validateCertificates(formats strfmt.Registry) error {
+
+	if err := validate.Required("certificates", "body", m.Certificates); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Certificates); i++ {
+		if swag.IsZero(m.Certificates[i]) { // not required
+			continue
+		}
+
+		if m.Certificates[i] != nil {
+			if err := m.Certificates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this certificate chain based on the context it is used
+func (m *CertificateChain) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCertificates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CertificateChain) contextValidateCertificates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if m.Certificates[i] != nil {
+
+			if swag.IsZero(m.Certificates[i]) { // not required
+				return nil
+			}
+
+			if err := m.Certificates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CertificateChain) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CertificateChain) UnmarshalBinary(b []byte) error {
+	var res CertificateChain
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CertificateInfo certificate info
+//
+// swagger:model CertificateInfo
+type CertificateInfo struct {
+
+	// issuer
+	// Required: true
+	Issuer *string `json:"issuer"`
+
+	// not after
+	// Required: true
+	// Format: date-time
+	NotAfter *strfmt.DateTime `json:"notAfter"`
+
+	// not before
+	// Required: true
+	// Format: date-time
+	NotBefore *strfmt.DateTime `json:"notBefore"`
+
+	// Algorithm and size or curve of the public key, such as ECDSA P-256 or RSA 4096
+	// Required: true
+	PublicKeyAlgorithm *string `json:"publicKeyAlgorithm"`
+
+	// serial number
+	// Required: true
+	SerialNumber *string `json:"serialNumber"`
+
+	// Hex encoded SHA-256 digest of the DER encoded certificate
+	// Required: true
+	Sha256Fingerprint *string `json:"sha256Fingerprint"`
+
+	// subject
+	// Required: true
+	Subject *string `json:"subject"`
+}
+
+// Validate validates this certificate info
+func (m *CertificateInfo) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIssuer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNotAfter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNotBefore(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePublicKeyAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSerialNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSha256Fingerprint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CertificateInfo) validateIssuer(formats strfmt.Registry) error {
+
+	if err := validate.Required("issuer", "body", m.Issuer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validateNotAfter(formats strfmt.Registry) error {
+
+	if err := validate.Required("notAfter", "body", m.NotAfter); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("notAfter", "body", "date-time", m.NotAfter.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validateNotBefore(formats strfmt.Registry) error {
+
+	if err := validate.Required("notBefore", "body", m.NotBefore); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("notBefore", "body", "date-time", m.NotBefore.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validatePublicKeyAlgorithm(formats strfmt.Registry) error {
+
+	if err := validate.Required("publicKeyAlgorithm", "body", m.PublicKeyAlgorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validateSerialNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("serialNumber", "body", m.SerialNumber); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validateSha256Fingerprint(formats strfmt.Registry) error {
+
+	if err := validate.Required("sha256Fingerprint", "body", m.Sha256Fingerprint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateInfo) validateSubject(formats strfmt.Registry) error {
+
+	if err := validate.Required("subject", "body", m.Subject); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this certificate info based on context it is used
+func (m *CertificateInfo) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CertificateInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CertificateInfo) UnmarshalBinary(b []byte) error {
+	var res CertificateInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
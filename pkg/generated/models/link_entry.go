@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LinkEntry link entry
+//
+// swagger:model LinkEntry
+type LinkEntry struct {
+
+	// Hex encoded head of the chain after the timestamp
+	// Required: true
+	Head *string `json:"head"`
+
+	// index
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// Hex encoded SHA-256 digest of the DER encoded TimeStampToken
+	// Required: true
+	TokenHash *string `json:"tokenHash"`
+}
+
+// Validate validates this link entry
+func (m *LinkEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHead(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenHash(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LinkEntry) validateHead(formats strfmt.Registry) error {
+
+	if err := validate.Required("head", "body", m.Head); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LinkEntry) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("index", "body", m.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LinkEntry) validateTokenHash(formats strfmt.Registry) error {
+
+	if err := validate.Required("tokenHash", "body", m.TokenHash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this link entry based on context it is used
+func (m *LinkEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LinkEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LinkEntry) UnmarshalBinary(b []byte) error {
+	var res LinkEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimestampInfo timestamp info
+//
+// swagger:model TimestampInfo
+type TimestampInfo struct {
+
+	// Policy OIDs a request may ask for in addition to the default policy. Any policy is accepted when empty
+	// Required: true
+	AcceptedPolicies []string `json:"acceptedPolicies"`
+
+	// Accuracy of the generation time of timestamps, as a duration such as 1s
+	// Required: true
+	Accuracy *string `json:"accuracy"`
+
+	// Describes which certificates are included in a timestamp. With leaf-on-request, only the signing certificate is included, and only when the request asks for certificates
+	// Required: true
+	// Enum: ["leaf-on-request"]
+	CertificateInclusion *string `json:"certificateInclusion"`
+
+	// Timestamping certificate chain, starting with the leaf certificate and ending with the root
+	// Required: true
+	Certificates []*CertificateInfo `json:"certificates"`
+
+	// Policy OID of timestamps for requests that do not ask for one
+	// Required: true
+	DefaultPolicy *string `json:"defaultPolicy"`
+
+	// Hash algorithms accepted for the message imprint of a request
+	// Required: true
+	HashAlgorithms []string `json:"hashAlgorithms"`
+
+	// Content types accepted for timestamp requests
+	// Required: true
+	RequestContentTypes []string `json:"requestContentTypes"`
+
+	// version
+	// Required: true
+	Version *VersionInfo `json:"version"`
+}
+
+// Validate validates this timestamp info
+func (m *TimestampInfo) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAcceptedPolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAccuracy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCertificateInclusion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCertificates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDefaultPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHashAlgorithms(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestContentTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimestampInfo) validateAcceptedPolicies(formats strfmt.Registry) error {
+
+	if err := validate.Required("acceptedPolicies", "body", m.AcceptedPolicies); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateAccuracy(formats strfmt.Registry) error {
+
+	if err := validate.Required("accuracy", "body", m.Accuracy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var timestampInfoTypeCertificateInclusionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["leaf-on-request"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		timestampInfoTypeCertificateInclusionPropEnum = append(timestampInfoTypeCertificateInclusionPropEnum, v)
+	}
+}
+
+const (
+
+	// TimestampInfoCertificateInclusionLeafDashOnDashRequest captures enum value "leaf-on-request"
+	TimestampInfoCertificateInclusionLeafDashOnDashRequest string = "leaf-on-request"
+)
+
+// prop value enum
+func (m *TimestampInfo) validateCertificateInclusionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, timestampInfoTypeCertificateInclusionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TimestampInfo) validateCertificateInclusion(formats strfmt.Registry) error {
+
+	if err := validate.Required("certificateInclusion", "body", m.CertificateInclusion); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCertificateInclusionEnum("certificateInclusion", "body", *m.CertificateInclusion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateCertificates(formats strfmt.Registry) error {
+
+	if err := validate.Required("certificates", "body", m.Certificates); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Certificates); i++ {
+		if swag.IsZero(m.Certificates[i]) { // not required
+			continue
+		}
+
+		if m.Certificates[i] != nil {
+			if err := m.Certificates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateDefaultPolicy(formats strfmt.Registry) error {
+
+	if err := validate.Required("defaultPolicy", "body", m.DefaultPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateHashAlgorithms(formats strfmt.Registry) error {
+
+	if err := validate.Required("hashAlgorithms", "body", m.HashAlgorithms); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateRequestContentTypes(formats strfmt.Registry) error {
+
+	if err := validate.Required("requestContentTypes", "body", m.RequestContentTypes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	if m.Version != nil {
+		if err := m.Version.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("version")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("version")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this timestamp info based on the context it is used
+func (m *TimestampInfo) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCertificates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersion(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimestampInfo) contextValidateCertificates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if m.Certificates[i] != nil {
+
+			if swag.IsZero(m.Certificates[i]) { // not required
+				return nil
+			}
+
+			if err := m.Certificates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TimestampInfo) contextValidateVersion(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Version != nil {
+
+		if err := m.Version.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("version")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("version")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimestampInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimestampInfo) UnmarshalBinary(b []byte) error {
+	var res TimestampInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
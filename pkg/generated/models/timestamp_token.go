@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimestampToken timestamp token
+//
+// swagger:model TimestampToken
+type TimestampToken struct {
+
+	// Accuracy of the generation time, as a duration such as 1s
+	Accuracy string `json:"accuracy,omitempty"`
+
+	// Certificates embedded in the timestamp response
+	Certificates []*CertificateInfo `json:"certificates"`
+
+	// gen time
+	// Required: true
+	// Format: date-time
+	GenTime *strfmt.DateTime `json:"genTime"`
+
+	// hash algorithm
+	// Required: true
+	HashAlgorithm *string `json:"hashAlgorithm"`
+
+	// Hex encoded digest of the timestamped artifact
+	// Required: true
+	HashedMessage *string `json:"hashedMessage"`
+
+	// nonce
+	Nonce string `json:"nonce,omitempty"`
+
+	// ordering
+	Ordering bool `json:"ordering,omitempty"`
+
+	// policy
+	// Required: true
+	Policy *string `json:"policy"`
+
+	// serial number
+	// Required: true
+	SerialNumber *string `json:"serialNumber"`
+}
+
+// Validate validates this timestamp token
+func (m *TimestampToken) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCertificates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGenTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHashAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHashedMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSerialNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimestampToken) validateCertificates(formats strfmt.Registry) error {
+	if swag.IsZero(m.Certificates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Certificates); i++ {
+		if swag.IsZero(m.Certificates[i]) { // not required
+			continue
+		}
+
+		if m.Certificates[i] != nil {
+			if err := m.Certificates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TimestampToken) validateGenTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("genTime", "body", m.GenTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("genTime", "body", "date-time", m.GenTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampToken) validateHashAlgorithm(formats strfmt.Registry) error {
+
+	if err := validate.Required("hashAlgorithm", "body", m.HashAlgorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampToken) validateHashedMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("hashedMessage", "body", m.HashedMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampToken) validatePolicy(formats strfmt.Registry) error {
+
+	if err := validate.Required("policy", "body", m.Policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimestampToken) validateSerialNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("serialNumber", "body", m.SerialNumber); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this timestamp token based on the context it is used
+func (m *TimestampToken) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCertificates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimestampToken) contextValidateCertificates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if m.Certificates[i] != nil {
+
+			if swag.IsZero(m.Certificates[i]) { // not required
+				return nil
+			}
+
+			if err := m.Certificates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimestampToken) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimestampToken) UnmarshalBinary(b []byte) error {
+	var res TimestampToken
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VerificationReport verification report
+//
+// swagger:model VerificationReport
+type VerificationReport struct {
+
+	// The certificates of the trusted chain, starting with the leaf certificate and ending with the root
+	Certificates []*CertificateInfo `json:"certificates"`
+
+	// checks
+	// Required: true
+	Checks []*VerificationCheck `json:"checks"`
+
+	// timestamp
+	// Required: true
+	Timestamp *TimestampToken `json:"timestamp"`
+
+	// The chain the checks were made against: the current chain of the timestamp authority, a chain it used before a rotation, or the chain supplied by the caller. When no chain verifies the timestamp response, the checks of the chain with the fewest failures are reported
+	//
+	// Enum: ["current","historical","supplied"]
+	TrustedChain string `json:"trustedChain,omitempty"`
+
+	// Whether every check passed
+	// Required: true
+	Verified *bool `json:"verified"`
+}
+
+// Validate validates this verification report
+func (m *VerificationReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCertificates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChecks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTrustedChain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVerified(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VerificationReport) validateCertificates(formats strfmt.Registry) error {
+	if swag.IsZero(m.Certificates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Certificates); i++ {
+		if swag.IsZero(m.Certificates[i]) { // not required
+			continue
+		}
+
+		if m.Certificates[i] != nil {
+			if err := m.Certificates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *VerificationReport) validateChecks(formats strfmt.Registry) error {
+
+	if err := validate.Required("checks", "body", m.Checks); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Checks); i++ {
+		if swag.IsZero(m.Checks[i]) { // not required
+			continue
+		}
+
+		if m.Checks[i] != nil {
+			if err := m.Checks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *VerificationReport) validateTimestamp(formats strfmt.Registry) error {
+
+	if err := validate.Required("timestamp", "body", m.Timestamp); err != nil {
+		return err
+	}
+
+	if m.Timestamp != nil {
+		if err := m.Timestamp.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("timestamp")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("timestamp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var verificationReportTypeTrustedChainPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["current","historical","supplied"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		verificationReportTypeTrustedChainPropEnum = append(verificationReportTypeTrustedChainPropEnum, v)
+	}
+}
+
+const (
+
+	// VerificationReportTrustedChainCurrent captures enum value "current"
+	VerificationReportTrustedChainCurrent string = "current"
+
+	// VerificationReportTrustedChainHistorical captures enum value "historical"
+	VerificationReportTrustedChainHistorical string = "historical"
+
+	// VerificationReportTrustedChainSupplied captures enum value "supplied"
+	VerificationReportTrustedChainSupplied string = "supplied"
+)
+
+// prop value enum
+func (m *VerificationReport) validateTrustedChainEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, verificationReportTypeTrustedChainPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VerificationReport) validateTrustedChain(formats strfmt.Registry) error {
+	if swag.IsZero(m.TrustedChain) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTrustedChainEnum("trustedChain", "body", m.TrustedChain); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VerificationReport) validateVerified(formats strfmt.Registry) error {
+
+	if err := validate.Required("verified", "body", m.Verified); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this verification report based on the context it is used
+func (m *VerificationReport) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCertificates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateChecks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTimestamp(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VerificationReport) contextValidateCertificates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if m.Certificates[i] != nil {
+
+			if swag.IsZero(m.Certificates[i]) { // not required
+				return nil
+			}
+
+			if err := m.Certificates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *VerificationReport) contextValidateChecks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Checks); i++ {
+
+		if m.Checks[i] != nil {
+
+			if swag.IsZero(m.Checks[i]) { // not required
+				return nil
+			}
+
+			if err := m.Checks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *VerificationReport) contextValidateTimestamp(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Timestamp != nil {
+
+		if err := m.Timestamp.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("timestamp")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("timestamp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VerificationReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VerificationReport) UnmarshalBinary(b []byte) error {
+	var res VerificationReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
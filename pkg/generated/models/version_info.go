@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// VersionInfo version info
+//
+// swagger:model VersionInfo
+type VersionInfo struct {
+
+	// build date
+	BuildDate string `json:"buildDate,omitempty"`
+
+	// compiler
+	Compiler string `json:"compiler,omitempty"`
+
+	// git commit
+	GitCommit string `json:"gitCommit,omitempty"`
+
+	// git tree state
+	GitTreeState string `json:"gitTreeState,omitempty"`
+
+	// git version
+	GitVersion string `json:"gitVersion,omitempty"`
+
+	// go version
+	GoVersion string `json:"goVersion,omitempty"`
+
+	// platform
+	Platform string `json:"platform,omitempty"`
+}
+
+// Validate validates this version info
+func (m *VersionInfo) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this version info based on context it is used
+func (m *VersionInfo) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VersionInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VersionInfo) UnmarshalBinary(b []byte) error {
+	var res VersionInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
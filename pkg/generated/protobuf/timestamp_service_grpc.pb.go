@@ -0,0 +1,263 @@
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/timestamp_service.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimestampService_Timestamp_FullMethodName      = "/dev.sigstore.timestamp.v1.TimestampService/Timestamp"
+	TimestampService_BatchTimestamp_FullMethodName = "/dev.sigstore.timestamp.v1.TimestampService/BatchTimestamp"
+	TimestampService_GetCertChain_FullMethodName   = "/dev.sigstore.timestamp.v1.TimestampService/GetCertChain"
+	TimestampService_GetInfo_FullMethodName        = "/dev.sigstore.timestamp.v1.TimestampService/GetInfo"
+)
+
+// TimestampServiceClient is the client API for TimestampService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TimestampService issues RFC 3161 timestamps, like the REST API.
+type TimestampServiceClient interface {
+	// Timestamp issues a timestamp for a RFC 3161 TimeStampReq.
+	Timestamp(ctx context.Context, in *TimestampRequest, opts ...grpc.CallOption) (*TimestampResponse, error)
+	// BatchTimestamp issues a timestamp for each request. A rejected request
+	// does not fail the others.
+	BatchTimestamp(ctx context.Context, in *BatchTimestampRequest, opts ...grpc.CallOption) (*BatchTimestampResponse, error)
+	// GetCertChain returns the timestamping certificate chain.
+	GetCertChain(ctx context.Context, in *GetCertChainRequest, opts ...grpc.CallOption) (*GetCertChainResponse, error)
+	// GetInfo describes the capabilities of the timestamp authority.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+}
+
+type timestampServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimestampServiceClient(cc grpc.ClientConnInterface) TimestampServiceClient {
+	return &timestampServiceClient{cc}
+}
+
+func (c *timestampServiceClient) Timestamp(ctx context.Context, in *TimestampRequest, opts ...grpc.CallOption) (*TimestampResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimestampResponse)
+	err := c.cc.Invoke(ctx, TimestampService_Timestamp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timestampServiceClient) BatchTimestamp(ctx context.Context, in *BatchTimestampRequest, opts ...grpc.CallOption) (*BatchTimestampResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchTimestampResponse)
+	err := c.cc.Invoke(ctx, TimestampService_BatchTimestamp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timestampServiceClient) GetCertChain(ctx context.Context, in *GetCertChainRequest, opts ...grpc.CallOption) (*GetCertChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCertChainResponse)
+	err := c.cc.Invoke(ctx, TimestampService_GetCertChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timestampServiceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, TimestampService_GetInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimestampServiceServer is the server API for TimestampService service.
+// All implementations must embed UnimplementedTimestampServiceServer
+// for forward compatibility.
+//
+// TimestampService issues RFC 3161 timestamps, like the REST API.
+type TimestampServiceServer interface {
+	// Timestamp issues a timestamp for a RFC 3161 TimeStampReq.
+	Timestamp(context.Context, *TimestampRequest) (*TimestampResponse, error)
+	// BatchTimestamp issues a timestamp for each request. A rejected request
+	// does not fail the others.
+	BatchTimestamp(context.Context, *BatchTimestampRequest) (*BatchTimestampResponse, error)
+	// GetCertChain returns the timestamping certificate chain.
+	GetCertChain(context.Context, *GetCertChainRequest) (*GetCertChainResponse, error)
+	// GetInfo describes the capabilities of the timestamp authority.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	mustEmbedUnimplementedTimestampServiceServer()
+}
+
+// UnimplementedTimestampServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimestampServiceServer struct{}
+
+func (UnimplementedTimestampServiceServer) Timestamp(context.Context, *TimestampRequest) (*TimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timestamp not implemented")
+}
+func (UnimplementedTimestampServiceServer) BatchTimestamp(context.Context, *BatchTimestampRequest) (*BatchTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTimestamp not implemented")
+}
+func (UnimplementedTimestampServiceServer) GetCertChain(context.Context, *GetCertChainRequest) (*GetCertChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertChain not implemented")
+}
+func (UnimplementedTimestampServiceServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedTimestampServiceServer) mustEmbedUnimplementedTimestampServiceServer() {}
+func (UnimplementedTimestampServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTimestampServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimestampServiceServer will
+// result in compilation errors.
+type UnsafeTimestampServiceServer interface {
+	mustEmbedUnimplementedTimestampServiceServer()
+}
+
+func RegisterTimestampServiceServer(s grpc.ServiceRegistrar, srv TimestampServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimestampServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimestampService_ServiceDesc, srv)
+}
+
+func _TimestampService_Timestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimestampServiceServer).Timestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimestampService_Timestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimestampServiceServer).Timestamp(ctx, req.(*TimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimestampService_BatchTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimestampServiceServer).BatchTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimestampService_BatchTimestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimestampServiceServer).BatchTimestamp(ctx, req.(*BatchTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimestampService_GetCertChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimestampServiceServer).GetCertChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimestampService_GetCertChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimestampServiceServer).GetCertChain(ctx, req.(*GetCertChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimestampService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimestampServiceServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimestampService_GetInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimestampServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimestampService_ServiceDesc is the grpc.ServiceDesc for TimestampService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimestampService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dev.sigstore.timestamp.v1.TimestampService",
+	HandlerType: (*TimestampServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Timestamp",
+			Handler:    _TimestampService_Timestamp_Handler,
+		},
+		{
+			MethodName: "BatchTimestamp",
+			Handler:    _TimestampService_BatchTimestamp_Handler,
+		},
+		{
+			MethodName: "GetCertChain",
+			Handler:    _TimestampService_GetCertChain_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _TimestampService_GetInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/timestamp_service.proto",
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations/timestamp"
 	"github.com/sigstore/timestamp-authority/pkg/internal/cmdparams"
 	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
 )
 
 //go:generate swagger generate server --target ../../generated --name TimestampServer --spec ../../../openapi.yaml --principal interface{} --exclude-main --exclude-spec
@@ -61,25 +62,49 @@ func configureAPI(api *operations.TimestampServerAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 	api.ApplicationPemCertificateChainProducer = runtime.TextProducer()
+	api.ApplicationPkcs7MimeProducer = runtime.ByteStreamProducer()
+	api.ApplicationPkixCertProducer = runtime.ByteStreamProducer()
 	api.ApplicationTimestampQueryConsumer = runtime.ByteStreamConsumer()
 	api.ApplicationTimestampReplyProducer = runtime.ByteStreamProducer()
 
 	api.TimestampGetTimestampResponseHandler = timestamp.GetTimestampResponseHandlerFunc(pkgapi.TimestampResponseHandler)
+	api.TimestampGetTimestampResponseForArtifactHandler = timestamp.GetTimestampResponseForArtifactHandlerFunc(pkgapi.TimestampResponseForArtifactHandler)
+	api.TimestampGetAuthenticodeTimestampResponseHandler = timestamp.GetAuthenticodeTimestampResponseHandlerFunc(pkgapi.AuthenticodeTimestampResponseHandler)
 	api.TimestampGetTimestampCertChainHandler = timestamp.GetTimestampCertChainHandlerFunc(pkgapi.GetTimestampCertChainHandler)
+	api.TimestampGetTimestampInfoHandler = timestamp.GetTimestampInfoHandlerFunc(pkgapi.GetTimestampInfoHandler)
+	api.TimestampGetTimestampTrustedRootHandler = timestamp.GetTimestampTrustedRootHandlerFunc(pkgapi.GetTimestampTrustedRootHandler)
+	api.TimestampVerifyTimestampResponseHandler = timestamp.VerifyTimestampResponseHandlerFunc(pkgapi.VerifyTimestampResponseHandler)
+	api.TimestampGetTimestampLinksHandler = timestamp.GetTimestampLinksHandlerFunc(pkgapi.GetTimestampLinksHandler)
 
-	api.PreServerShutdown = func() {}
+	api.PreServerShutdown = pkgapi.PreServerShutdown
 
 	api.ServerShutdown = func() {}
 
 	api.AddMiddlewareFor("POST", "/api/v1/timestamp", middleware.NoCache)
+	api.AddMiddlewareFor("POST", "/api/v1/timestamp/artifact", middleware.NoCache)
+	api.AddMiddlewareFor("POST", "/api/v1/timestamp/authenticode", middleware.NoCache)
+	api.AddMiddlewareFor("POST", "/api/v1/timestamp/verify", func(h http.Handler) http.Handler {
+		return middleware.NoCache(pkgapi.LimitVerifyRequestSize(h))
+	})
 	api.AddMiddlewareFor("GET", "/api/v1/timestamp/certchain", cacheForDay)
+	api.AddMiddlewareFor("GET", "/api/v1/timestamp/info", middleware.NoCache)
+	api.AddMiddlewareFor("GET", "/api/v1/timestamp/links", middleware.NoCache)
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
 // The TLS configuration before HTTPS server starts.
-func configureTLS(_ *tls.Config) {
-	// Make all necessary changes to the TLS configuration here.
+func configureTLS(tlsConfig *tls.Config) {
+	override := cmdparams.TLSConfig
+	if override == nil {
+		return
+	}
+	tlsConfig.MinVersion = override.MinVersion
+	tlsConfig.ClientAuth = override.ClientAuth
+	tlsConfig.ClientCAs = override.ClientCAs
+	// serve the certificate through GetCertificate, so that it can be reloaded
+	tlsConfig.Certificates = nil
+	tlsConfig.GetCertificate = override.GetCertificate
 }
 
 // As soon as server is initialized but not run yet, this function will be called.
@@ -104,13 +129,14 @@ func (l *logAdapter) Print(v ...interface{}) {
 }
 
 const pingPath = "/ping"
+const readyPath = "/ready"
 
 // httpPingOnly custom middleware prohibits all entrypoints except
-// "/ping" on the http (non-HTTPS) server.
+// "/ping" and "/ready" on the http (non-HTTPS) server.
 func httpPingOnly() func(http.Handler) http.Handler {
 	f := func(h http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
-			if r.URL.Scheme != "https" && !strings.EqualFold(r.URL.Path, pingPath) {
+			if r.URL.Scheme != "https" && !strings.EqualFold(r.URL.Path, pingPath) && !strings.EqualFold(r.URL.Path, readyPath) {
 				w.Header().Set("Content-Type", "text/plain")
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte("http server supports only the " + pingPath + " entrypoint")) //nolint:errcheck
@@ -123,6 +149,29 @@ func httpPingOnly() func(http.Handler) http.Handler {
 	return f
 }
 
+// readiness custom middleware serves the readiness state of the API on the
+// given path, responding with 503 while the API should not receive traffic.
+func readiness(path string) func(http.Handler) http.Handler {
+	f := func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if (r.Method == http.MethodGet || r.Method == http.MethodHead) && strings.EqualFold(r.URL.Path, path) {
+				w.Header().Set("Content-Type", "text/plain")
+				if err := pkgapi.Ready(); err != nil {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					w.Write([]byte(err.Error())) //nolint:errcheck
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(".")) //nolint:errcheck
+				return
+			}
+			h.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+	return f
+}
+
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics.
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
@@ -131,6 +180,7 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 	returnHandler := middleware.Logger(handler)
 	returnHandler = middleware.Recoverer(returnHandler)
 	returnHandler = middleware.Heartbeat(pingPath)(returnHandler)
+	returnHandler = readiness(readyPath)(returnHandler)
 	if cmdparams.IsHTTPPingOnly {
 		returnHandler = httpPingOnly()(returnHandler)
 	}
@@ -139,6 +189,7 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 	returnHandler = handleCORS(returnHandler)
 
 	returnHandler = wrapMetrics(returnHandler)
+	returnHandler = tracing.Middleware(returnHandler)
 
 	return middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
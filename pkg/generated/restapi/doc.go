@@ -25,11 +25,17 @@
 //
 //	Consumes:
 //	  - application/timestamp-query
+//	  - application/octet-stream
 //	  - application/json
+//	  - multipart/form-data
 //
 //	Produces:
 //	  - application/pem-certificate-chain
+//	  - application/pkcs7-mime
+//	  - application/pkix-cert
 //	  - application/timestamp-reply
+//	  - application/octet-stream
+//	  - application/json
 //
 // swagger:meta
 package restapi
@@ -89,14 +89,138 @@ func init() {
         }
       }
     },
+    "/api/v1/timestamp/artifact": {
+      "post": {
+        "description": "For clients that cannot build a TimeStampReq, the raw artifact is streamed in the request body and hashed by the server, which returns a timestamp response over the digest. The digest is returned in the X-Artifact-Digest header as \u003calgorithm\u003e:\u003chex digest\u003e. The artifact is not retained. Disabled unless artifact hashing is enabled in the server configuration.\n",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/timestamp-reply"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Hashes an uploaded artifact and generates a timestamp response over its digest",
+        "operationId": "getTimestampResponseForArtifact",
+        "parameters": [
+          {
+            "enum": [
+              "sha256",
+              "sha384",
+              "sha512"
+            ],
+            "type": "string",
+            "default": "sha256",
+            "description": "Hash algorithm used to hash the artifact",
+            "name": "hashAlgorithm",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the timestamping certificate chain in the response",
+            "name": "certificates",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The TSA policy requested for the timestamp",
+            "name": "tsaPolicyOID",
+            "in": "query"
+          },
+          {
+            "name": "artifact",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Returns a timestamp response over the digest of the artifact",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Artifact-Digest": {
+                "type": "string",
+                "description": "The digest of the artifact, as \u003calgorithm\u003e:\u003chex digest\u003e"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadContent"
+          },
+          "413": {
+            "$ref": "#/responses/PayloadTooLarge"
+          },
+          "501": {
+            "$ref": "#/responses/NotImplemented"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/authenticode": {
+      "post": {
+        "description": "Implements the Authenticode legacy timestamp protocol used by signtool /t and older Windows signing tools. The request is a base64 encoded TimeStampRequest holding the signature to countersign, and the response is a base64 encoded PKCS#7 SignedData countersignature, signed with the same certificate chain as RFC 3161 timestamps.\n",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Generates a Microsoft Authenticode legacy timestamp",
+        "operationId": "getAuthenticodeTimestampResponse",
+        "parameters": [
+          {
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the base64 encoded PKCS#7 countersignature",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadContent"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
     "/api/v1/timestamp/certchain": {
       "get": {
-        "description": "Returns the certificate chain for timestamping that can be used to validate trusted timestamps",
+        "description": "Returns the certificate chain for timestamping that can be used to validate trusted timestamps. The format is selected with the Accept header: a PEM encoded chain (the default), a certs-only PKCS#7 SignedData bundle (application/pkcs7-mime), the DER encoded leaf certificate only (application/pkix-cert), or a CertificateChain JSON document describing each certificate (application/json).\n",
         "consumes": [
           "application/json"
         ],
         "produces": [
-          "application/pem-certificate-chain"
+          "application/pem-certificate-chain",
+          "application/pkcs7-mime",
+          "application/pkix-cert",
+          "application/json"
         ],
         "tags": [
           "timestamp"
@@ -105,7 +229,7 @@ func init() {
         "operationId": "getTimestampCertChain",
         "responses": {
           "200": {
-            "description": "The PEM encoded cert chain",
+            "description": "The cert chain in the requested format",
             "schema": {
               "type": "string"
             }
@@ -118,9 +242,221 @@ func init() {
           }
         }
       }
+    },
+    "/api/v1/timestamp/info": {
+      "get": {
+        "description": "Returns the supported hash algorithms, policies, accuracy and certificate chain of the timestamp authority, along with the server version",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Describe the capabilities of the timestamp authority",
+        "operationId": "getTimestampInfo",
+        "responses": {
+          "200": {
+            "description": "The capabilities of the timestamp authority",
+            "schema": {
+              "$ref": "#/definitions/TimestampInfo"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/links": {
+      "get": {
+        "description": "Returns the entries of the hash chain linking issued timestamps, from start up to but excluding end, along with the current length and head of the chain. Each timestamp carries its index in the chain and the head before it, so that it can be verified to lead to a later head. At most 1000 entries are returned. Disabled unless linking is enabled in the server configuration.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Get the link chain of linked timestamps",
+        "operationId": "getTimestampLinks",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The index of the first entry",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The index after the last entry, defaults to the length of the chain",
+            "name": "end",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The requested entries of the link chain",
+            "schema": {
+              "$ref": "#/definitions/LinkChain"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadContent"
+          },
+          "501": {
+            "$ref": "#/responses/NotImplemented"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/trustedroot": {
+      "get": {
+        "description": "Returns a Sigstore TrustedRoot JSON document holding only timestampAuthorities entries, one for each certificate chain used by the timestamp authority, including chains used before a rotation\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Describe the timestamp authority as Sigstore TrustedRoot entries",
+        "operationId": "getTimestampTrustedRoot",
+        "responses": {
+          "200": {
+            "description": "The TrustedRoot fragment describing the timestamp authority",
+            "schema": {
+              "type": "object"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/verify": {
+      "post": {
+        "description": "For clients that cannot verify CMS signatures and X.509 certificate chains themselves, the server verifies a timestamp response over an artifact, or over its digest, against each certificate chain used by the timestamp authority, including chains used before a rotation, and against a chain supplied by the caller. Returns the outcome of each check and the decoded timestamp. Uploading the artifact is disabled unless artifact hashing is enabled in the server configuration.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Verify a timestamp response",
+        "operationId": "verifyTimestampResponse",
+        "parameters": [
+          {
+            "type": "file",
+            "description": "The DER or base64 encoded timestamp response",
+            "name": "tsr",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The timestamped artifact. Either the artifact or its digest must be provided",
+            "name": "artifact",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The digest of the timestamped artifact, as \u003calgorithm\u003e:\u003chex digest\u003e",
+            "name": "digest",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "A PEM encoded certificate chain to trust in addition to the chains of the timestamp authority, starting with the timestamping certificate and ending with the root\n",
+            "name": "certificateChain",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The verification report, whether or not the timestamp response was verified",
+            "schema": {
+              "$ref": "#/definitions/VerificationReport"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadContent"
+          },
+          "413": {
+            "$ref": "#/responses/PayloadTooLarge"
+          },
+          "501": {
+            "$ref": "#/responses/NotImplemented"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
     }
   },
   "definitions": {
+    "CertificateChain": {
+      "type": "object",
+      "required": [
+        "certificates"
+      ],
+      "properties": {
+        "certificates": {
+          "description": "Timestamping certificate chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        }
+      }
+    },
+    "CertificateInfo": {
+      "type": "object",
+      "required": [
+        "subject",
+        "issuer",
+        "serialNumber",
+        "notBefore",
+        "notAfter",
+        "sha256Fingerprint",
+        "publicKeyAlgorithm"
+      ],
+      "properties": {
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "notBefore": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "publicKeyAlgorithm": {
+          "description": "Algorithm and size or curve of the public key, such as ECDSA P-256 or RSA 4096",
+          "type": "string"
+        },
+        "serialNumber": {
+          "type": "string"
+        },
+        "sha256Fingerprint": {
+          "description": "Hex encoded SHA-256 digest of the DER encoded certificate",
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "properties": {
@@ -131,6 +467,245 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "LinkChain": {
+      "type": "object",
+      "required": [
+        "length",
+        "head",
+        "entries"
+      ],
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LinkEntry"
+          }
+        },
+        "head": {
+          "description": "Hex encoded head of the chain after its last timestamp",
+          "type": "string"
+        },
+        "length": {
+          "description": "The number of timestamps in the chain",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "LinkEntry": {
+      "type": "object",
+      "required": [
+        "index",
+        "tokenHash",
+        "head"
+      ],
+      "properties": {
+        "head": {
+          "description": "Hex encoded head of the chain after the timestamp",
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tokenHash": {
+          "description": "Hex encoded SHA-256 digest of the DER encoded TimeStampToken",
+          "type": "string"
+        }
+      }
+    },
+    "TimestampInfo": {
+      "type": "object",
+      "required": [
+        "hashAlgorithms",
+        "defaultPolicy",
+        "acceptedPolicies",
+        "accuracy",
+        "requestContentTypes",
+        "certificateInclusion",
+        "certificates",
+        "version"
+      ],
+      "properties": {
+        "acceptedPolicies": {
+          "description": "Policy OIDs a request may ask for in addition to the default policy. Any policy is accepted when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "accuracy": {
+          "description": "Accuracy of the generation time of timestamps, as a duration such as 1s",
+          "type": "string"
+        },
+        "certificateInclusion": {
+          "description": "Describes which certificates are included in a timestamp. With leaf-on-request, only the signing certificate is included, and only when the request asks for certificates",
+          "type": "string",
+          "enum": [
+            "leaf-on-request"
+          ]
+        },
+        "certificates": {
+          "description": "Timestamping certificate chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "defaultPolicy": {
+          "description": "Policy OID of timestamps for requests that do not ask for one",
+          "type": "string"
+        },
+        "hashAlgorithms": {
+          "description": "Hash algorithms accepted for the message imprint of a request",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "requestContentTypes": {
+          "description": "Content types accepted for timestamp requests",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "version": {
+          "$ref": "#/definitions/VersionInfo"
+        }
+      }
+    },
+    "TimestampToken": {
+      "type": "object",
+      "required": [
+        "genTime",
+        "policy",
+        "serialNumber",
+        "hashAlgorithm",
+        "hashedMessage"
+      ],
+      "properties": {
+        "accuracy": {
+          "description": "Accuracy of the generation time, as a duration such as 1s",
+          "type": "string"
+        },
+        "certificates": {
+          "description": "Certificates embedded in the timestamp response",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "genTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hashAlgorithm": {
+          "type": "string"
+        },
+        "hashedMessage": {
+          "description": "Hex encoded digest of the timestamped artifact",
+          "type": "string"
+        },
+        "nonce": {
+          "type": "string"
+        },
+        "ordering": {
+          "type": "boolean"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "serialNumber": {
+          "type": "string"
+        }
+      }
+    },
+    "VerificationCheck": {
+      "type": "object",
+      "required": [
+        "name",
+        "passed"
+      ],
+      "properties": {
+        "error": {
+          "description": "Why the check failed",
+          "type": "string"
+        },
+        "name": {
+          "description": "The check, such as signature, tsaCertificate, essCertID, timestampingEKU or messageImprint",
+          "type": "string"
+        },
+        "passed": {
+          "type": "boolean"
+        }
+      }
+    },
+    "VerificationReport": {
+      "type": "object",
+      "required": [
+        "verified",
+        "checks",
+        "timestamp"
+      ],
+      "properties": {
+        "certificates": {
+          "description": "The certificates of the trusted chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VerificationCheck"
+          }
+        },
+        "timestamp": {
+          "$ref": "#/definitions/TimestampToken"
+        },
+        "trustedChain": {
+          "description": "The chain the checks were made against: the current chain of the timestamp authority, a chain it used before a rotation, or the chain supplied by the caller. When no chain verifies the timestamp response, the checks of the chain with the fewest failures are reported\n",
+          "type": "string",
+          "enum": [
+            "current",
+            "historical",
+            "supplied"
+          ]
+        },
+        "verified": {
+          "description": "Whether every check passed",
+          "type": "boolean"
+        }
+      }
+    },
+    "VersionInfo": {
+      "type": "object",
+      "properties": {
+        "buildDate": {
+          "type": "string"
+        },
+        "compiler": {
+          "type": "string"
+        },
+        "gitCommit": {
+          "type": "string"
+        },
+        "gitTreeState": {
+          "type": "string"
+        },
+        "gitVersion": {
+          "type": "string"
+        },
+        "goVersion": {
+          "type": "string"
+        },
+        "platform": {
+          "type": "string"
+        }
+      }
     }
   },
   "responses": {
@@ -151,6 +726,12 @@ func init() {
     },
     "NotImplemented": {
       "description": "The content requested is not implemented"
+    },
+    "PayloadTooLarge": {
+      "description": "The content supplied to the server exceeds the maximum size",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
     }
   }
 }`))
@@ -169,17 +750,156 @@ func init() {
     "/api/v1/timestamp": {
       "post": {
         "consumes": [
-          "application/timestamp-query",
-          "application/json"
+          "application/timestamp-query",
+          "application/json"
+        ],
+        "produces": [
+          "application/timestamp-reply"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Generates a new timestamp response and creates a new log entry for the timestamp in the transparency log",
+        "operationId": "getTimestampResponse",
+        "parameters": [
+          {
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Returns a timestamp response and the location of the log entry in the transprency log",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "The content supplied to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "501": {
+            "description": "The content requested is not implemented"
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/artifact": {
+      "post": {
+        "description": "For clients that cannot build a TimeStampReq, the raw artifact is streamed in the request body and hashed by the server, which returns a timestamp response over the digest. The digest is returned in the X-Artifact-Digest header as \u003calgorithm\u003e:\u003chex digest\u003e. The artifact is not retained. Disabled unless artifact hashing is enabled in the server configuration.\n",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/timestamp-reply"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Hashes an uploaded artifact and generates a timestamp response over its digest",
+        "operationId": "getTimestampResponseForArtifact",
+        "parameters": [
+          {
+            "enum": [
+              "sha256",
+              "sha384",
+              "sha512"
+            ],
+            "type": "string",
+            "default": "sha256",
+            "description": "Hash algorithm used to hash the artifact",
+            "name": "hashAlgorithm",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the timestamping certificate chain in the response",
+            "name": "certificates",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The TSA policy requested for the timestamp",
+            "name": "tsaPolicyOID",
+            "in": "query"
+          },
+          {
+            "name": "artifact",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Returns a timestamp response over the digest of the artifact",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Artifact-Digest": {
+                "type": "string",
+                "description": "The digest of the artifact, as \u003calgorithm\u003e:\u003chex digest\u003e"
+              }
+            }
+          },
+          "400": {
+            "description": "The content supplied to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "413": {
+            "description": "The content supplied to the server exceeds the maximum size",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "501": {
+            "description": "The content requested is not implemented"
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/authenticode": {
+      "post": {
+        "description": "Implements the Authenticode legacy timestamp protocol used by signtool /t and older Windows signing tools. The request is a base64 encoded TimeStampRequest holding the signature to countersign, and the response is a base64 encoded PKCS#7 SignedData countersignature, signed with the same certificate chain as RFC 3161 timestamps.\n",
+        "consumes": [
+          "application/octet-stream"
         ],
         "produces": [
-          "application/timestamp-reply"
+          "application/octet-stream"
         ],
         "tags": [
           "timestamp"
         ],
-        "summary": "Generates a new timestamp response and creates a new log entry for the timestamp in the transparency log",
-        "operationId": "getTimestampResponse",
+        "summary": "Generates a Microsoft Authenticode legacy timestamp",
+        "operationId": "getAuthenticodeTimestampResponse",
         "parameters": [
           {
             "name": "request",
@@ -192,8 +912,8 @@ func init() {
           }
         ],
         "responses": {
-          "201": {
-            "description": "Returns a timestamp response and the location of the log entry in the transprency log",
+          "200": {
+            "description": "Returns the base64 encoded PKCS#7 countersignature",
             "schema": {
               "type": "string",
               "format": "binary"
@@ -205,9 +925,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "501": {
-            "description": "The content requested is not implemented"
-          },
           "default": {
             "description": "There was an internal error in the server while processing the request",
             "schema": {
@@ -219,12 +936,15 @@ func init() {
     },
     "/api/v1/timestamp/certchain": {
       "get": {
-        "description": "Returns the certificate chain for timestamping that can be used to validate trusted timestamps",
+        "description": "Returns the certificate chain for timestamping that can be used to validate trusted timestamps. The format is selected with the Accept header: a PEM encoded chain (the default), a certs-only PKCS#7 SignedData bundle (application/pkcs7-mime), the DER encoded leaf certificate only (application/pkix-cert), or a CertificateChain JSON document describing each certificate (application/json).\n",
         "consumes": [
           "application/json"
         ],
         "produces": [
-          "application/pem-certificate-chain"
+          "application/pem-certificate-chain",
+          "application/pkcs7-mime",
+          "application/pkix-cert",
+          "application/json"
         ],
         "tags": [
           "timestamp"
@@ -233,7 +953,7 @@ func init() {
         "operationId": "getTimestampCertChain",
         "responses": {
           "200": {
-            "description": "The PEM encoded cert chain",
+            "description": "The cert chain in the requested format",
             "schema": {
               "type": "string"
             }
@@ -249,9 +969,244 @@ func init() {
           }
         }
       }
+    },
+    "/api/v1/timestamp/info": {
+      "get": {
+        "description": "Returns the supported hash algorithms, policies, accuracy and certificate chain of the timestamp authority, along with the server version",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Describe the capabilities of the timestamp authority",
+        "operationId": "getTimestampInfo",
+        "responses": {
+          "200": {
+            "description": "The capabilities of the timestamp authority",
+            "schema": {
+              "$ref": "#/definitions/TimestampInfo"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/links": {
+      "get": {
+        "description": "Returns the entries of the hash chain linking issued timestamps, from start up to but excluding end, along with the current length and head of the chain. Each timestamp carries its index in the chain and the head before it, so that it can be verified to lead to a later head. At most 1000 entries are returned. Disabled unless linking is enabled in the server configuration.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Get the link chain of linked timestamps",
+        "operationId": "getTimestampLinks",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The index of the first entry",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The index after the last entry, defaults to the length of the chain",
+            "name": "end",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The requested entries of the link chain",
+            "schema": {
+              "$ref": "#/definitions/LinkChain"
+            }
+          },
+          "400": {
+            "description": "The content supplied to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "501": {
+            "description": "The content requested is not implemented"
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/trustedroot": {
+      "get": {
+        "description": "Returns a Sigstore TrustedRoot JSON document holding only timestampAuthorities entries, one for each certificate chain used by the timestamp authority, including chains used before a rotation\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Describe the timestamp authority as Sigstore TrustedRoot entries",
+        "operationId": "getTimestampTrustedRoot",
+        "responses": {
+          "200": {
+            "description": "The TrustedRoot fragment describing the timestamp authority",
+            "schema": {
+              "type": "object"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/timestamp/verify": {
+      "post": {
+        "description": "For clients that cannot verify CMS signatures and X.509 certificate chains themselves, the server verifies a timestamp response over an artifact, or over its digest, against each certificate chain used by the timestamp authority, including chains used before a rotation, and against a chain supplied by the caller. Returns the outcome of each check and the decoded timestamp. Uploading the artifact is disabled unless artifact hashing is enabled in the server configuration.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "timestamp"
+        ],
+        "summary": "Verify a timestamp response",
+        "operationId": "verifyTimestampResponse",
+        "parameters": [
+          {
+            "type": "file",
+            "description": "The DER or base64 encoded timestamp response",
+            "name": "tsr",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The timestamped artifact. Either the artifact or its digest must be provided",
+            "name": "artifact",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The digest of the timestamped artifact, as \u003calgorithm\u003e:\u003chex digest\u003e",
+            "name": "digest",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "A PEM encoded certificate chain to trust in addition to the chains of the timestamp authority, starting with the timestamping certificate and ending with the root\n",
+            "name": "certificateChain",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The verification report, whether or not the timestamp response was verified",
+            "schema": {
+              "$ref": "#/definitions/VerificationReport"
+            }
+          },
+          "400": {
+            "description": "The content supplied to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "413": {
+            "description": "The content supplied to the server exceeds the maximum size",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "501": {
+            "description": "The content requested is not implemented"
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
+    "CertificateChain": {
+      "type": "object",
+      "required": [
+        "certificates"
+      ],
+      "properties": {
+        "certificates": {
+          "description": "Timestamping certificate chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        }
+      }
+    },
+    "CertificateInfo": {
+      "type": "object",
+      "required": [
+        "subject",
+        "issuer",
+        "serialNumber",
+        "notBefore",
+        "notAfter",
+        "sha256Fingerprint",
+        "publicKeyAlgorithm"
+      ],
+      "properties": {
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "notBefore": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "publicKeyAlgorithm": {
+          "description": "Algorithm and size or curve of the public key, such as ECDSA P-256 or RSA 4096",
+          "type": "string"
+        },
+        "serialNumber": {
+          "type": "string"
+        },
+        "sha256Fingerprint": {
+          "description": "Hex encoded SHA-256 digest of the DER encoded certificate",
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "properties": {
@@ -262,6 +1217,245 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "LinkChain": {
+      "type": "object",
+      "required": [
+        "length",
+        "head",
+        "entries"
+      ],
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LinkEntry"
+          }
+        },
+        "head": {
+          "description": "Hex encoded head of the chain after its last timestamp",
+          "type": "string"
+        },
+        "length": {
+          "description": "The number of timestamps in the chain",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "LinkEntry": {
+      "type": "object",
+      "required": [
+        "index",
+        "tokenHash",
+        "head"
+      ],
+      "properties": {
+        "head": {
+          "description": "Hex encoded head of the chain after the timestamp",
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tokenHash": {
+          "description": "Hex encoded SHA-256 digest of the DER encoded TimeStampToken",
+          "type": "string"
+        }
+      }
+    },
+    "TimestampInfo": {
+      "type": "object",
+      "required": [
+        "hashAlgorithms",
+        "defaultPolicy",
+        "acceptedPolicies",
+        "accuracy",
+        "requestContentTypes",
+        "certificateInclusion",
+        "certificates",
+        "version"
+      ],
+      "properties": {
+        "acceptedPolicies": {
+          "description": "Policy OIDs a request may ask for in addition to the default policy. Any policy is accepted when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "accuracy": {
+          "description": "Accuracy of the generation time of timestamps, as a duration such as 1s",
+          "type": "string"
+        },
+        "certificateInclusion": {
+          "description": "Describes which certificates are included in a timestamp. With leaf-on-request, only the signing certificate is included, and only when the request asks for certificates",
+          "type": "string",
+          "enum": [
+            "leaf-on-request"
+          ]
+        },
+        "certificates": {
+          "description": "Timestamping certificate chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "defaultPolicy": {
+          "description": "Policy OID of timestamps for requests that do not ask for one",
+          "type": "string"
+        },
+        "hashAlgorithms": {
+          "description": "Hash algorithms accepted for the message imprint of a request",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "requestContentTypes": {
+          "description": "Content types accepted for timestamp requests",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "version": {
+          "$ref": "#/definitions/VersionInfo"
+        }
+      }
+    },
+    "TimestampToken": {
+      "type": "object",
+      "required": [
+        "genTime",
+        "policy",
+        "serialNumber",
+        "hashAlgorithm",
+        "hashedMessage"
+      ],
+      "properties": {
+        "accuracy": {
+          "description": "Accuracy of the generation time, as a duration such as 1s",
+          "type": "string"
+        },
+        "certificates": {
+          "description": "Certificates embedded in the timestamp response",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "genTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hashAlgorithm": {
+          "type": "string"
+        },
+        "hashedMessage": {
+          "description": "Hex encoded digest of the timestamped artifact",
+          "type": "string"
+        },
+        "nonce": {
+          "type": "string"
+        },
+        "ordering": {
+          "type": "boolean"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "serialNumber": {
+          "type": "string"
+        }
+      }
+    },
+    "VerificationCheck": {
+      "type": "object",
+      "required": [
+        "name",
+        "passed"
+      ],
+      "properties": {
+        "error": {
+          "description": "Why the check failed",
+          "type": "string"
+        },
+        "name": {
+          "description": "The check, such as signature, tsaCertificate, essCertID, timestampingEKU or messageImprint",
+          "type": "string"
+        },
+        "passed": {
+          "type": "boolean"
+        }
+      }
+    },
+    "VerificationReport": {
+      "type": "object",
+      "required": [
+        "verified",
+        "checks",
+        "timestamp"
+      ],
+      "properties": {
+        "certificates": {
+          "description": "The certificates of the trusted chain, starting with the leaf certificate and ending with the root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CertificateInfo"
+          }
+        },
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VerificationCheck"
+          }
+        },
+        "timestamp": {
+          "$ref": "#/definitions/TimestampToken"
+        },
+        "trustedChain": {
+          "description": "The chain the checks were made against: the current chain of the timestamp authority, a chain it used before a rotation, or the chain supplied by the caller. When no chain verifies the timestamp response, the checks of the chain with the fewest failures are reported\n",
+          "type": "string",
+          "enum": [
+            "current",
+            "historical",
+            "supplied"
+          ]
+        },
+        "verified": {
+          "description": "Whether every check passed",
+          "type": "boolean"
+        }
+      }
+    },
+    "VersionInfo": {
+      "type": "object",
+      "properties": {
+        "buildDate": {
+          "type": "string"
+        },
+        "compiler": {
+          "type": "string"
+        },
+        "gitCommit": {
+          "type": "string"
+        },
+        "gitTreeState": {
+          "type": "string"
+        },
+        "gitVersion": {
+          "type": "string"
+        },
+        "goVersion": {
+          "type": "string"
+        },
+        "platform": {
+          "type": "string"
+        }
+      }
     }
   },
   "responses": {
@@ -282,6 +1476,12 @@ func init() {
     },
     "NotImplemented": {
       "description": "The content requested is not implemented"
+    },
+    "PayloadTooLarge": {
+      "description": "The content supplied to the server exceeds the maximum size",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
     }
   }
 }`))
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAuthenticodeTimestampResponseHandlerFunc turns a function with the right signature into a get authenticode timestamp response handler
+type GetAuthenticodeTimestampResponseHandlerFunc func(GetAuthenticodeTimestampResponseParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAuthenticodeTimestampResponseHandlerFunc) Handle(params GetAuthenticodeTimestampResponseParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAuthenticodeTimestampResponseHandler interface for that can handle valid get authenticode timestamp response params
+type GetAuthenticodeTimestampResponseHandler interface {
+	Handle(GetAuthenticodeTimestampResponseParams) middleware.Responder
+}
+
+// NewGetAuthenticodeTimestampResponse creates a new http.Handler for the get authenticode timestamp response operation
+func NewGetAuthenticodeTimestampResponse(ctx *middleware.Context, handler GetAuthenticodeTimestampResponseHandler) *GetAuthenticodeTimestampResponse {
+	return &GetAuthenticodeTimestampResponse{Context: ctx, Handler: handler}
+}
+
+/*
+	GetAuthenticodeTimestampResponse swagger:route POST /api/v1/timestamp/authenticode timestamp getAuthenticodeTimestampResponse
+
+# Generates a Microsoft Authenticode legacy timestamp
+
+Implements the Authenticode legacy timestamp protocol used by signtool /t and older Windows signing tools. The request is a base64 encoded TimeStampRequest holding the signature to countersign, and the response is a base64 encoded PKCS#7 SignedData countersignature, signed with the same certificate chain as RFC 3161 timestamps.
+*/
+type GetAuthenticodeTimestampResponse struct {
+	Context *middleware.Context
+	Handler GetAuthenticodeTimestampResponseHandler
+}
+
+func (o *GetAuthenticodeTimestampResponse) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAuthenticodeTimestampResponseParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewGetAuthenticodeTimestampResponseParams creates a new GetAuthenticodeTimestampResponseParams object
+//
+// There are no default values defined in the spec.
+func NewGetAuthenticodeTimestampResponseParams() GetAuthenticodeTimestampResponseParams {
+
+	return GetAuthenticodeTimestampResponseParams{}
+}
+
+// GetAuthenticodeTimestampResponseParams contains all the bound params for the get authenticode timestamp response operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getAuthenticodeTimestampResponse
+type GetAuthenticodeTimestampResponseParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Request io.ReadCloser
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAuthenticodeTimestampResponseParams() beforehand.
+func (o *GetAuthenticodeTimestampResponseParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		o.Request = r.Body
+	} else {
+		res = append(res, errors.Required("request", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
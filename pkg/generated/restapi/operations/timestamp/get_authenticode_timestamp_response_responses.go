@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetAuthenticodeTimestampResponseOKCode is the HTTP code returned for type GetAuthenticodeTimestampResponseOK
+const GetAuthenticodeTimestampResponseOKCode int = 200
+
+/*
+GetAuthenticodeTimestampResponseOK Returns the base64 encoded PKCS#7 countersignature
+
+swagger:response getAuthenticodeTimestampResponseOK
+*/
+type GetAuthenticodeTimestampResponseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetAuthenticodeTimestampResponseOK creates GetAuthenticodeTimestampResponseOK with default headers values
+func NewGetAuthenticodeTimestampResponseOK() *GetAuthenticodeTimestampResponseOK {
+
+	return &GetAuthenticodeTimestampResponseOK{}
+}
+
+// WithPayload adds the payload to the get authenticode timestamp response o k response
+func (o *GetAuthenticodeTimestampResponseOK) WithPayload(payload io.ReadCloser) *GetAuthenticodeTimestampResponseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get authenticode timestamp response o k response
+func (o *GetAuthenticodeTimestampResponseOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuthenticodeTimestampResponseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetAuthenticodeTimestampResponseBadRequestCode is the HTTP code returned for type GetAuthenticodeTimestampResponseBadRequest
+const GetAuthenticodeTimestampResponseBadRequestCode int = 400
+
+/*
+GetAuthenticodeTimestampResponseBadRequest The content supplied to the server was invalid
+
+swagger:response getAuthenticodeTimestampResponseBadRequest
+*/
+type GetAuthenticodeTimestampResponseBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAuthenticodeTimestampResponseBadRequest creates GetAuthenticodeTimestampResponseBadRequest with default headers values
+func NewGetAuthenticodeTimestampResponseBadRequest() *GetAuthenticodeTimestampResponseBadRequest {
+
+	return &GetAuthenticodeTimestampResponseBadRequest{}
+}
+
+// WithPayload adds the payload to the get authenticode timestamp response bad request response
+func (o *GetAuthenticodeTimestampResponseBadRequest) WithPayload(payload *models.Error) *GetAuthenticodeTimestampResponseBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get authenticode timestamp response bad request response
+func (o *GetAuthenticodeTimestampResponseBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuthenticodeTimestampResponseBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetAuthenticodeTimestampResponseDefault There was an internal error in the server while processing the request
+
+swagger:response getAuthenticodeTimestampResponseDefault
+*/
+type GetAuthenticodeTimestampResponseDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAuthenticodeTimestampResponseDefault creates GetAuthenticodeTimestampResponseDefault with default headers values
+func NewGetAuthenticodeTimestampResponseDefault(code int) *GetAuthenticodeTimestampResponseDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAuthenticodeTimestampResponseDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get authenticode timestamp response default response
+func (o *GetAuthenticodeTimestampResponseDefault) WithStatusCode(code int) *GetAuthenticodeTimestampResponseDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get authenticode timestamp response default response
+func (o *GetAuthenticodeTimestampResponseDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get authenticode timestamp response default response
+func (o *GetAuthenticodeTimestampResponseDefault) WithPayload(payload *models.Error) *GetAuthenticodeTimestampResponseDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get authenticode timestamp response default response
+func (o *GetAuthenticodeTimestampResponseDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuthenticodeTimestampResponseDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
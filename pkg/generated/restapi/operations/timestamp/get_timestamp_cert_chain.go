@@ -49,7 +49,7 @@ func NewGetTimestampCertChain(ctx *middleware.Context, handler GetTimestampCertC
 
 # Retrieve the certificate chain for timestamping that can be used to validate trusted timestamps
 
-Returns the certificate chain for timestamping that can be used to validate trusted timestamps
+Returns the certificate chain for timestamping that can be used to validate trusted timestamps. The format is selected with the Accept header: a PEM encoded chain (the default), a certs-only PKCS#7 SignedData bundle (application/pkcs7-mime), the DER encoded leaf certificate only (application/pkix-cert), or a CertificateChain JSON document describing each certificate (application/json).
 */
 type GetTimestampCertChain struct {
 	Context *middleware.Context
@@ -32,7 +32,7 @@ import (
 const GetTimestampCertChainOKCode int = 200
 
 /*
-GetTimestampCertChainOK The PEM encoded cert chain
+GetTimestampCertChainOK The cert chain in the requested format
 
 swagger:response getTimestampCertChainOK
 */
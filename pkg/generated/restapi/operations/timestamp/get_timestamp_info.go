@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTimestampInfoHandlerFunc turns a function with the right signature into a get timestamp info handler
+type GetTimestampInfoHandlerFunc func(GetTimestampInfoParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTimestampInfoHandlerFunc) Handle(params GetTimestampInfoParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetTimestampInfoHandler interface for that can handle valid get timestamp info params
+type GetTimestampInfoHandler interface {
+	Handle(GetTimestampInfoParams) middleware.Responder
+}
+
+// NewGetTimestampInfo creates a new http.Handler for the get timestamp info operation
+func NewGetTimestampInfo(ctx *middleware.Context, handler GetTimestampInfoHandler) *GetTimestampInfo {
+	return &GetTimestampInfo{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTimestampInfo swagger:route GET /api/v1/timestamp/info timestamp getTimestampInfo
+
+# Describe the capabilities of the timestamp authority
+
+Returns the supported hash algorithms, policies, accuracy and certificate chain of the timestamp authority, along with the server version
+*/
+type GetTimestampInfo struct {
+	Context *middleware.Context
+	Handler GetTimestampInfoHandler
+}
+
+func (o *GetTimestampInfo) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTimestampInfoParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
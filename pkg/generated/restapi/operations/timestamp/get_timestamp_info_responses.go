@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampInfoOKCode is the HTTP code returned for type GetTimestampInfoOK
+const GetTimestampInfoOKCode int = 200
+
+/*
+GetTimestampInfoOK The capabilities of the timestamp authority
+
+swagger:response getTimestampInfoOK
+*/
+type GetTimestampInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TimestampInfo `json:"body,omitempty"`
+}
+
+// NewGetTimestampInfoOK creates GetTimestampInfoOK with default headers values
+func NewGetTimestampInfoOK() *GetTimestampInfoOK {
+
+	return &GetTimestampInfoOK{}
+}
+
+// WithPayload adds the payload to the get timestamp info o k response
+func (o *GetTimestampInfoOK) WithPayload(payload *models.TimestampInfo) *GetTimestampInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp info o k response
+func (o *GetTimestampInfoOK) SetPayload(payload *models.TimestampInfo) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetTimestampInfoDefault There was an internal error in the server while processing the request
+
+swagger:response getTimestampInfoDefault
+*/
+type GetTimestampInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampInfoDefault creates GetTimestampInfoDefault with default headers values
+func NewGetTimestampInfoDefault(code int) *GetTimestampInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTimestampInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get timestamp info default response
+func (o *GetTimestampInfoDefault) WithStatusCode(code int) *GetTimestampInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get timestamp info default response
+func (o *GetTimestampInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get timestamp info default response
+func (o *GetTimestampInfoDefault) WithPayload(payload *models.Error) *GetTimestampInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp info default response
+func (o *GetTimestampInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTimestampLinksHandlerFunc turns a function with the right signature into a get timestamp links handler
+type GetTimestampLinksHandlerFunc func(GetTimestampLinksParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTimestampLinksHandlerFunc) Handle(params GetTimestampLinksParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetTimestampLinksHandler interface for that can handle valid get timestamp links params
+type GetTimestampLinksHandler interface {
+	Handle(GetTimestampLinksParams) middleware.Responder
+}
+
+// NewGetTimestampLinks creates a new http.Handler for the get timestamp links operation
+func NewGetTimestampLinks(ctx *middleware.Context, handler GetTimestampLinksHandler) *GetTimestampLinks {
+	return &GetTimestampLinks{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTimestampLinks swagger:route GET /api/v1/timestamp/links timestamp getTimestampLinks
+
+# Get the link chain of linked timestamps
+
+Returns the entries of the hash chain linking issued timestamps, from start up to but excluding end, along with the current length and head of the chain. Each timestamp carries its index in the chain and the head before it, so that it can be verified to lead to a later head. At most 1000 entries are returned. Disabled unless linking is enabled in the server configuration.
+*/
+type GetTimestampLinks struct {
+	Context *middleware.Context
+	Handler GetTimestampLinksHandler
+}
+
+func (o *GetTimestampLinks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTimestampLinksParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
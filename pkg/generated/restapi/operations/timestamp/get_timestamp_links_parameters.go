@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetTimestampLinksParams creates a new GetTimestampLinksParams object
+// with the default values initialized.
+func NewGetTimestampLinksParams() GetTimestampLinksParams {
+
+	var (
+		// initialize parameters with default values
+
+		startDefault = int64(0)
+	)
+
+	return GetTimestampLinksParams{
+		Start: &startDefault,
+	}
+}
+
+// GetTimestampLinksParams contains all the bound params for the get timestamp links operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getTimestampLinks
+type GetTimestampLinksParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The index after the last entry, defaults to the length of the chain
+	  Minimum: 0
+	  In: query
+	*/
+	End *int64
+	/*The index of the first entry
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Start *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetTimestampLinksParams() beforehand.
+func (o *GetTimestampLinksParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qEnd, qhkEnd, _ := qs.GetOK("end")
+	if err := o.bindEnd(qEnd, qhkEnd, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStart, qhkStart, _ := qs.GetOK("start")
+	if err := o.bindStart(qStart, qhkStart, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindEnd binds and validates parameter End from query.
+func (o *GetTimestampLinksParams) bindEnd(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("end", "query", "int64", raw)
+	}
+	o.End = &value
+
+	if err := o.validateEnd(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEnd carries on validations for parameter End
+func (o *GetTimestampLinksParams) validateEnd(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("end", "query", *o.End, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStart binds and validates parameter Start from query.
+func (o *GetTimestampLinksParams) bindStart(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTimestampLinksParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("start", "query", "int64", raw)
+	}
+	o.Start = &value
+
+	if err := o.validateStart(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStart carries on validations for parameter Start
+func (o *GetTimestampLinksParams) validateStart(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("start", "query", *o.Start, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
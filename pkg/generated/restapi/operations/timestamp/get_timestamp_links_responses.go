@@ -0,0 +1,203 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampLinksOKCode is the HTTP code returned for type GetTimestampLinksOK
+const GetTimestampLinksOKCode int = 200
+
+/*
+GetTimestampLinksOK The requested entries of the link chain
+
+swagger:response getTimestampLinksOK
+*/
+type GetTimestampLinksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LinkChain `json:"body,omitempty"`
+}
+
+// NewGetTimestampLinksOK creates GetTimestampLinksOK with default headers values
+func NewGetTimestampLinksOK() *GetTimestampLinksOK {
+
+	return &GetTimestampLinksOK{}
+}
+
+// WithPayload adds the payload to the get timestamp links o k response
+func (o *GetTimestampLinksOK) WithPayload(payload *models.LinkChain) *GetTimestampLinksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp links o k response
+func (o *GetTimestampLinksOK) SetPayload(payload *models.LinkChain) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampLinksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTimestampLinksBadRequestCode is the HTTP code returned for type GetTimestampLinksBadRequest
+const GetTimestampLinksBadRequestCode int = 400
+
+/*
+GetTimestampLinksBadRequest The content supplied to the server was invalid
+
+swagger:response getTimestampLinksBadRequest
+*/
+type GetTimestampLinksBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampLinksBadRequest creates GetTimestampLinksBadRequest with default headers values
+func NewGetTimestampLinksBadRequest() *GetTimestampLinksBadRequest {
+
+	return &GetTimestampLinksBadRequest{}
+}
+
+// WithPayload adds the payload to the get timestamp links bad request response
+func (o *GetTimestampLinksBadRequest) WithPayload(payload *models.Error) *GetTimestampLinksBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp links bad request response
+func (o *GetTimestampLinksBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampLinksBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTimestampLinksNotImplementedCode is the HTTP code returned for type GetTimestampLinksNotImplemented
+const GetTimestampLinksNotImplementedCode int = 501
+
+/*
+GetTimestampLinksNotImplemented The content requested is not implemented
+
+swagger:response getTimestampLinksNotImplemented
+*/
+type GetTimestampLinksNotImplemented struct {
+}
+
+// NewGetTimestampLinksNotImplemented creates GetTimestampLinksNotImplemented with default headers values
+func NewGetTimestampLinksNotImplemented() *GetTimestampLinksNotImplemented {
+
+	return &GetTimestampLinksNotImplemented{}
+}
+
+// WriteResponse to the client
+func (o *GetTimestampLinksNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(501)
+}
+
+/*
+GetTimestampLinksDefault There was an internal error in the server while processing the request
+
+swagger:response getTimestampLinksDefault
+*/
+type GetTimestampLinksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampLinksDefault creates GetTimestampLinksDefault with default headers values
+func NewGetTimestampLinksDefault(code int) *GetTimestampLinksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTimestampLinksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get timestamp links default response
+func (o *GetTimestampLinksDefault) WithStatusCode(code int) *GetTimestampLinksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get timestamp links default response
+func (o *GetTimestampLinksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get timestamp links default response
+func (o *GetTimestampLinksDefault) WithPayload(payload *models.Error) *GetTimestampLinksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp links default response
+func (o *GetTimestampLinksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampLinksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
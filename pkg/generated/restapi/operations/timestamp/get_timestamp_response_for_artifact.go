@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTimestampResponseForArtifactHandlerFunc turns a function with the right signature into a get timestamp response for artifact handler
+type GetTimestampResponseForArtifactHandlerFunc func(GetTimestampResponseForArtifactParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTimestampResponseForArtifactHandlerFunc) Handle(params GetTimestampResponseForArtifactParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetTimestampResponseForArtifactHandler interface for that can handle valid get timestamp response for artifact params
+type GetTimestampResponseForArtifactHandler interface {
+	Handle(GetTimestampResponseForArtifactParams) middleware.Responder
+}
+
+// NewGetTimestampResponseForArtifact creates a new http.Handler for the get timestamp response for artifact operation
+func NewGetTimestampResponseForArtifact(ctx *middleware.Context, handler GetTimestampResponseForArtifactHandler) *GetTimestampResponseForArtifact {
+	return &GetTimestampResponseForArtifact{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTimestampResponseForArtifact swagger:route POST /api/v1/timestamp/artifact timestamp getTimestampResponseForArtifact
+
+# Hashes an uploaded artifact and generates a timestamp response over its digest
+
+For clients that cannot build a TimeStampReq, the raw artifact is streamed in the request body and hashed by the server, which returns a timestamp response over the digest. The digest is returned in the X-Artifact-Digest header as <algorithm>:<hex digest>. The artifact is not retained. Disabled unless artifact hashing is enabled in the server configuration.
+*/
+type GetTimestampResponseForArtifact struct {
+	Context *middleware.Context
+	Handler GetTimestampResponseForArtifactHandler
+}
+
+func (o *GetTimestampResponseForArtifact) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTimestampResponseForArtifactParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetTimestampResponseForArtifactParams creates a new GetTimestampResponseForArtifactParams object
+// with the default values initialized.
+func NewGetTimestampResponseForArtifactParams() GetTimestampResponseForArtifactParams {
+
+	var (
+		// initialize parameters with default values
+
+		certificatesDefault  = bool(false)
+		hashAlgorithmDefault = string("sha256")
+	)
+
+	return GetTimestampResponseForArtifactParams{
+		Certificates: &certificatesDefault,
+
+		HashAlgorithm: &hashAlgorithmDefault,
+	}
+}
+
+// GetTimestampResponseForArtifactParams contains all the bound params for the get timestamp response for artifact operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getTimestampResponseForArtifact
+type GetTimestampResponseForArtifactParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Artifact io.ReadCloser
+	/*Whether to include the timestamping certificate chain in the response
+	  In: query
+	  Default: false
+	*/
+	Certificates *bool
+	/*Hash algorithm used to hash the artifact
+	  In: query
+	  Default: "sha256"
+	*/
+	HashAlgorithm *string
+	/*The TSA policy requested for the timestamp
+	  In: query
+	*/
+	TsaPolicyOID *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetTimestampResponseForArtifactParams() beforehand.
+func (o *GetTimestampResponseForArtifactParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if runtime.HasBody(r) {
+		o.Artifact = r.Body
+	} else {
+		res = append(res, errors.Required("artifact", "body", ""))
+	}
+
+	qCertificates, qhkCertificates, _ := qs.GetOK("certificates")
+	if err := o.bindCertificates(qCertificates, qhkCertificates, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHashAlgorithm, qhkHashAlgorithm, _ := qs.GetOK("hashAlgorithm")
+	if err := o.bindHashAlgorithm(qHashAlgorithm, qhkHashAlgorithm, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTsaPolicyOID, qhkTsaPolicyOID, _ := qs.GetOK("tsaPolicyOID")
+	if err := o.bindTsaPolicyOID(qTsaPolicyOID, qhkTsaPolicyOID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCertificates binds and validates parameter Certificates from query.
+func (o *GetTimestampResponseForArtifactParams) bindCertificates(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTimestampResponseForArtifactParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("certificates", "query", "bool", raw)
+	}
+	o.Certificates = &value
+
+	return nil
+}
+
+// bindHashAlgorithm binds and validates parameter HashAlgorithm from query.
+func (o *GetTimestampResponseForArtifactParams) bindHashAlgorithm(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTimestampResponseForArtifactParams()
+		return nil
+	}
+	o.HashAlgorithm = &raw
+
+	if err := o.validateHashAlgorithm(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHashAlgorithm carries on validations for parameter HashAlgorithm
+func (o *GetTimestampResponseForArtifactParams) validateHashAlgorithm(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("hashAlgorithm", "query", *o.HashAlgorithm, []interface{}{"sha256", "sha384", "sha512"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTsaPolicyOID binds and validates parameter TsaPolicyOID from query.
+func (o *GetTimestampResponseForArtifactParams) bindTsaPolicyOID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.TsaPolicyOID = &raw
+
+	return nil
+}
@@ -0,0 +1,269 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampResponseForArtifactCreatedCode is the HTTP code returned for type GetTimestampResponseForArtifactCreated
+const GetTimestampResponseForArtifactCreatedCode int = 201
+
+/*
+GetTimestampResponseForArtifactCreated Returns a timestamp response over the digest of the artifact
+
+swagger:response getTimestampResponseForArtifactCreated
+*/
+type GetTimestampResponseForArtifactCreated struct {
+	/*The digest of the artifact, as <algorithm>:<hex digest>
+
+	 */
+	XArtifactDigest string `json:"X-Artifact-Digest"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetTimestampResponseForArtifactCreated creates GetTimestampResponseForArtifactCreated with default headers values
+func NewGetTimestampResponseForArtifactCreated() *GetTimestampResponseForArtifactCreated {
+
+	return &GetTimestampResponseForArtifactCreated{}
+}
+
+// WithXArtifactDigest adds the xArtifactDigest to the get timestamp response for artifact created response
+func (o *GetTimestampResponseForArtifactCreated) WithXArtifactDigest(xArtifactDigest string) *GetTimestampResponseForArtifactCreated {
+	o.XArtifactDigest = xArtifactDigest
+	return o
+}
+
+// SetXArtifactDigest sets the xArtifactDigest to the get timestamp response for artifact created response
+func (o *GetTimestampResponseForArtifactCreated) SetXArtifactDigest(xArtifactDigest string) {
+	o.XArtifactDigest = xArtifactDigest
+}
+
+// WithPayload adds the payload to the get timestamp response for artifact created response
+func (o *GetTimestampResponseForArtifactCreated) WithPayload(payload io.ReadCloser) *GetTimestampResponseForArtifactCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp response for artifact created response
+func (o *GetTimestampResponseForArtifactCreated) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampResponseForArtifactCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header X-Artifact-Digest
+
+	xArtifactDigest := o.XArtifactDigest
+	if xArtifactDigest != "" {
+		rw.Header().Set("X-Artifact-Digest", xArtifactDigest)
+	}
+
+	rw.WriteHeader(201)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetTimestampResponseForArtifactBadRequestCode is the HTTP code returned for type GetTimestampResponseForArtifactBadRequest
+const GetTimestampResponseForArtifactBadRequestCode int = 400
+
+/*
+GetTimestampResponseForArtifactBadRequest The content supplied to the server was invalid
+
+swagger:response getTimestampResponseForArtifactBadRequest
+*/
+type GetTimestampResponseForArtifactBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampResponseForArtifactBadRequest creates GetTimestampResponseForArtifactBadRequest with default headers values
+func NewGetTimestampResponseForArtifactBadRequest() *GetTimestampResponseForArtifactBadRequest {
+
+	return &GetTimestampResponseForArtifactBadRequest{}
+}
+
+// WithPayload adds the payload to the get timestamp response for artifact bad request response
+func (o *GetTimestampResponseForArtifactBadRequest) WithPayload(payload *models.Error) *GetTimestampResponseForArtifactBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp response for artifact bad request response
+func (o *GetTimestampResponseForArtifactBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampResponseForArtifactBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTimestampResponseForArtifactRequestEntityTooLargeCode is the HTTP code returned for type GetTimestampResponseForArtifactRequestEntityTooLarge
+const GetTimestampResponseForArtifactRequestEntityTooLargeCode int = 413
+
+/*
+GetTimestampResponseForArtifactRequestEntityTooLarge The content supplied to the server exceeds the maximum size
+
+swagger:response getTimestampResponseForArtifactRequestEntityTooLarge
+*/
+type GetTimestampResponseForArtifactRequestEntityTooLarge struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampResponseForArtifactRequestEntityTooLarge creates GetTimestampResponseForArtifactRequestEntityTooLarge with default headers values
+func NewGetTimestampResponseForArtifactRequestEntityTooLarge() *GetTimestampResponseForArtifactRequestEntityTooLarge {
+
+	return &GetTimestampResponseForArtifactRequestEntityTooLarge{}
+}
+
+// WithPayload adds the payload to the get timestamp response for artifact request entity too large response
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) WithPayload(payload *models.Error) *GetTimestampResponseForArtifactRequestEntityTooLarge {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp response for artifact request entity too large response
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampResponseForArtifactRequestEntityTooLarge) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(413)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTimestampResponseForArtifactNotImplementedCode is the HTTP code returned for type GetTimestampResponseForArtifactNotImplemented
+const GetTimestampResponseForArtifactNotImplementedCode int = 501
+
+/*
+GetTimestampResponseForArtifactNotImplemented The content requested is not implemented
+
+swagger:response getTimestampResponseForArtifactNotImplemented
+*/
+type GetTimestampResponseForArtifactNotImplemented struct {
+}
+
+// NewGetTimestampResponseForArtifactNotImplemented creates GetTimestampResponseForArtifactNotImplemented with default headers values
+func NewGetTimestampResponseForArtifactNotImplemented() *GetTimestampResponseForArtifactNotImplemented {
+
+	return &GetTimestampResponseForArtifactNotImplemented{}
+}
+
+// WriteResponse to the client
+func (o *GetTimestampResponseForArtifactNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(501)
+}
+
+/*
+GetTimestampResponseForArtifactDefault There was an internal error in the server while processing the request
+
+swagger:response getTimestampResponseForArtifactDefault
+*/
+type GetTimestampResponseForArtifactDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampResponseForArtifactDefault creates GetTimestampResponseForArtifactDefault with default headers values
+func NewGetTimestampResponseForArtifactDefault(code int) *GetTimestampResponseForArtifactDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTimestampResponseForArtifactDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get timestamp response for artifact default response
+func (o *GetTimestampResponseForArtifactDefault) WithStatusCode(code int) *GetTimestampResponseForArtifactDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get timestamp response for artifact default response
+func (o *GetTimestampResponseForArtifactDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get timestamp response for artifact default response
+func (o *GetTimestampResponseForArtifactDefault) WithPayload(payload *models.Error) *GetTimestampResponseForArtifactDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp response for artifact default response
+func (o *GetTimestampResponseForArtifactDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampResponseForArtifactDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
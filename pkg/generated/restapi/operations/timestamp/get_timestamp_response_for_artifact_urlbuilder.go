@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetTimestampResponseForArtifactURL generates an URL for the get timestamp response for artifact operation
+type GetTimestampResponseForArtifactURL struct {
+	Certificates  *bool
+	HashAlgorithm *string
+	TsaPolicyOID  *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetTimestampResponseForArtifactURL) WithBasePath(bp string) *GetTimestampResponseForArtifactURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetTimestampResponseForArtifactURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetTimestampResponseForArtifactURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/api/v1/timestamp/artifact"
+
+	_basePath := o._basePath
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var certificatesQ string
+	if o.Certificates != nil {
+		certificatesQ = swag.FormatBool(*o.Certificates)
+	}
+	if certificatesQ != "" {
+		qs.Set("certificates", certificatesQ)
+	}
+
+	var hashAlgorithmQ string
+	if o.HashAlgorithm != nil {
+		hashAlgorithmQ = *o.HashAlgorithm
+	}
+	if hashAlgorithmQ != "" {
+		qs.Set("hashAlgorithm", hashAlgorithmQ)
+	}
+
+	var tsaPolicyOIDQ string
+	if o.TsaPolicyOID != nil {
+		tsaPolicyOIDQ = *o.TsaPolicyOID
+	}
+	if tsaPolicyOIDQ != "" {
+		qs.Set("tsaPolicyOID", tsaPolicyOIDQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetTimestampResponseForArtifactURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetTimestampResponseForArtifactURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetTimestampResponseForArtifactURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetTimestampResponseForArtifactURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetTimestampResponseForArtifactURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetTimestampResponseForArtifactURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTimestampTrustedRootHandlerFunc turns a function with the right signature into a get timestamp trusted root handler
+type GetTimestampTrustedRootHandlerFunc func(GetTimestampTrustedRootParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTimestampTrustedRootHandlerFunc) Handle(params GetTimestampTrustedRootParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetTimestampTrustedRootHandler interface for that can handle valid get timestamp trusted root params
+type GetTimestampTrustedRootHandler interface {
+	Handle(GetTimestampTrustedRootParams) middleware.Responder
+}
+
+// NewGetTimestampTrustedRoot creates a new http.Handler for the get timestamp trusted root operation
+func NewGetTimestampTrustedRoot(ctx *middleware.Context, handler GetTimestampTrustedRootHandler) *GetTimestampTrustedRoot {
+	return &GetTimestampTrustedRoot{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTimestampTrustedRoot swagger:route GET /api/v1/timestamp/trustedroot timestamp getTimestampTrustedRoot
+
+# Describe the timestamp authority as Sigstore TrustedRoot entries
+
+Returns a Sigstore TrustedRoot JSON document holding only timestampAuthorities entries, one for each certificate chain used by the timestamp authority, including chains used before a rotation
+*/
+type GetTimestampTrustedRoot struct {
+	Context *middleware.Context
+	Handler GetTimestampTrustedRootHandler
+}
+
+func (o *GetTimestampTrustedRoot) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTimestampTrustedRootParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// GetTimestampTrustedRootOKCode is the HTTP code returned for type GetTimestampTrustedRootOK
+const GetTimestampTrustedRootOKCode int = 200
+
+/*
+GetTimestampTrustedRootOK The TrustedRoot fragment describing the timestamp authority
+
+swagger:response getTimestampTrustedRootOK
+*/
+type GetTimestampTrustedRootOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload interface{} `json:"body,omitempty"`
+}
+
+// NewGetTimestampTrustedRootOK creates GetTimestampTrustedRootOK with default headers values
+func NewGetTimestampTrustedRootOK() *GetTimestampTrustedRootOK {
+
+	return &GetTimestampTrustedRootOK{}
+}
+
+// WithPayload adds the payload to the get timestamp trusted root o k response
+func (o *GetTimestampTrustedRootOK) WithPayload(payload interface{}) *GetTimestampTrustedRootOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp trusted root o k response
+func (o *GetTimestampTrustedRootOK) SetPayload(payload interface{}) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampTrustedRootOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetTimestampTrustedRootDefault There was an internal error in the server while processing the request
+
+swagger:response getTimestampTrustedRootDefault
+*/
+type GetTimestampTrustedRootDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTimestampTrustedRootDefault creates GetTimestampTrustedRootDefault with default headers values
+func NewGetTimestampTrustedRootDefault(code int) *GetTimestampTrustedRootDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTimestampTrustedRootDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get timestamp trusted root default response
+func (o *GetTimestampTrustedRootDefault) WithStatusCode(code int) *GetTimestampTrustedRootDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get timestamp trusted root default response
+func (o *GetTimestampTrustedRootDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get timestamp trusted root default response
+func (o *GetTimestampTrustedRootDefault) WithPayload(payload *models.Error) *GetTimestampTrustedRootDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get timestamp trusted root default response
+func (o *GetTimestampTrustedRootDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTimestampTrustedRootDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// VerifyTimestampResponseHandlerFunc turns a function with the right signature into a verify timestamp response handler
+type VerifyTimestampResponseHandlerFunc func(VerifyTimestampResponseParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn VerifyTimestampResponseHandlerFunc) Handle(params VerifyTimestampResponseParams) middleware.Responder {
+	return fn(params)
+}
+
+// VerifyTimestampResponseHandler interface for that can handle valid verify timestamp response params
+type VerifyTimestampResponseHandler interface {
+	Handle(VerifyTimestampResponseParams) middleware.Responder
+}
+
+// NewVerifyTimestampResponse creates a new http.Handler for the verify timestamp response operation
+func NewVerifyTimestampResponse(ctx *middleware.Context, handler VerifyTimestampResponseHandler) *VerifyTimestampResponse {
+	return &VerifyTimestampResponse{Context: ctx, Handler: handler}
+}
+
+/*
+	VerifyTimestampResponse swagger:route POST /api/v1/timestamp/verify timestamp verifyTimestampResponse
+
+# Verify a timestamp response
+
+For clients that cannot verify CMS signatures and X.509 certificate chains themselves, the server verifies a timestamp response over an artifact, or over its digest, against each certificate chain used by the timestamp authority, including chains used before a rotation, and against a chain supplied by the caller. Returns the outcome of each check and the decoded timestamp. Uploading the artifact is disabled unless artifact hashing is enabled in the server configuration.
+*/
+type VerifyTimestampResponse struct {
+	Context *middleware.Context
+	Handler VerifyTimestampResponseHandler
+}
+
+func (o *VerifyTimestampResponse) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewVerifyTimestampResponseParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// VerifyTimestampResponseMaxParseMemory sets the maximum size in bytes for
+// the multipart form parser for this operation.
+//
+// The default value is 32 MB.
+// The multipart parser stores up to this + 10MB.
+var VerifyTimestampResponseMaxParseMemory int64 = 32 << 20
+
+// NewVerifyTimestampResponseParams creates a new VerifyTimestampResponseParams object
+//
+// There are no default values defined in the spec.
+func NewVerifyTimestampResponseParams() VerifyTimestampResponseParams {
+
+	return VerifyTimestampResponseParams{}
+}
+
+// VerifyTimestampResponseParams contains all the bound params for the verify timestamp response operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters verifyTimestampResponse
+type VerifyTimestampResponseParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The timestamped artifact. Either the artifact or its digest must be provided
+	  In: formData
+	*/
+	Artifact io.ReadCloser
+	/*A PEM encoded certificate chain to trust in addition to the chains of the timestamp authority, starting with the timestamping certificate and ending with the root
+
+	  In: formData
+	*/
+	CertificateChain *string
+	/*The digest of the timestamped artifact, as <algorithm>:<hex digest>
+	  In: formData
+	*/
+	Digest *string
+	/*The DER or base64 encoded timestamp response
+	  Required: true
+	  In: formData
+	*/
+	Tsr io.ReadCloser
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewVerifyTimestampResponseParams() beforehand.
+func (o *VerifyTimestampResponseParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := r.ParseMultipartForm(VerifyTimestampResponseMaxParseMemory); err != nil {
+		if err != http.ErrNotMultipart {
+			return errors.New(400, "%v", err)
+		} else if err := r.ParseForm(); err != nil {
+			return errors.New(400, "%v", err)
+		}
+	}
+	fds := runtime.Values(r.Form)
+
+	artifact, artifactHeader, err := r.FormFile("artifact")
+	if err != nil && err != http.ErrMissingFile {
+		res = append(res, errors.New(400, "reading file %q failed: %v", "artifact", err))
+	} else if err == http.ErrMissingFile {
+		// no-op for missing but optional file parameter
+	} else if err := o.bindArtifact(artifact, artifactHeader); err != nil {
+		res = append(res, err)
+	} else {
+		o.Artifact = &runtime.File{Data: artifact, Header: artifactHeader}
+	}
+
+	fdCertificateChain, fdhkCertificateChain, _ := fds.GetOK("certificateChain")
+	if err := o.bindCertificateChain(fdCertificateChain, fdhkCertificateChain, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdDigest, fdhkDigest, _ := fds.GetOK("digest")
+	if err := o.bindDigest(fdDigest, fdhkDigest, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	tsr, tsrHeader, err := r.FormFile("tsr")
+	if err != nil {
+		res = append(res, errors.New(400, "reading file %q failed: %v", "tsr", err))
+	} else if err := o.bindTsr(tsr, tsrHeader); err != nil {
+		// Required: true
+		res = append(res, err)
+	} else {
+		o.Tsr = &runtime.File{Data: tsr, Header: tsrHeader}
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindArtifact binds file parameter Artifact.
+//
+// The only supported validations on files are MinLength and MaxLength
+func (o *VerifyTimestampResponseParams) bindArtifact(file multipart.File, header *multipart.FileHeader) error {
+	return nil
+}
+
+// bindCertificateChain binds and validates parameter CertificateChain from formData.
+func (o *VerifyTimestampResponseParams) bindCertificateChain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.CertificateChain = &raw
+
+	return nil
+}
+
+// bindDigest binds and validates parameter Digest from formData.
+func (o *VerifyTimestampResponseParams) bindDigest(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Digest = &raw
+
+	return nil
+}
+
+// bindTsr binds file parameter Tsr.
+//
+// The only supported validations on files are MinLength and MaxLength
+func (o *VerifyTimestampResponseParams) bindTsr(file multipart.File, header *multipart.FileHeader) error {
+	return nil
+}
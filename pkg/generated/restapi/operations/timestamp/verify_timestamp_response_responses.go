@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2022 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timestamp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+)
+
+// VerifyTimestampResponseOKCode is the HTTP code returned for type VerifyTimestampResponseOK
+const VerifyTimestampResponseOKCode int = 200
+
+/*
+VerifyTimestampResponseOK The verification report, whether or not the timestamp response was verified
+
+swagger:response verifyTimestampResponseOK
+*/
+type VerifyTimestampResponseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VerificationReport `json:"body,omitempty"`
+}
+
+// NewVerifyTimestampResponseOK creates VerifyTimestampResponseOK with default headers values
+func NewVerifyTimestampResponseOK() *VerifyTimestampResponseOK {
+
+	return &VerifyTimestampResponseOK{}
+}
+
+// WithPayload adds the payload to the verify timestamp response o k response
+func (o *VerifyTimestampResponseOK) WithPayload(payload *models.VerificationReport) *VerifyTimestampResponseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify timestamp response o k response
+func (o *VerifyTimestampResponseOK) SetPayload(payload *models.VerificationReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyTimestampResponseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VerifyTimestampResponseBadRequestCode is the HTTP code returned for type VerifyTimestampResponseBadRequest
+const VerifyTimestampResponseBadRequestCode int = 400
+
+/*
+VerifyTimestampResponseBadRequest The content supplied to the server was invalid
+
+swagger:response verifyTimestampResponseBadRequest
+*/
+type VerifyTimestampResponseBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewVerifyTimestampResponseBadRequest creates VerifyTimestampResponseBadRequest with default headers values
+func NewVerifyTimestampResponseBadRequest() *VerifyTimestampResponseBadRequest {
+
+	return &VerifyTimestampResponseBadRequest{}
+}
+
+// WithPayload adds the payload to the verify timestamp response bad request response
+func (o *VerifyTimestampResponseBadRequest) WithPayload(payload *models.Error) *VerifyTimestampResponseBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify timestamp response bad request response
+func (o *VerifyTimestampResponseBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyTimestampResponseBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VerifyTimestampResponseRequestEntityTooLargeCode is the HTTP code returned for type VerifyTimestampResponseRequestEntityTooLarge
+const VerifyTimestampResponseRequestEntityTooLargeCode int = 413
+
+/*
+VerifyTimestampResponseRequestEntityTooLarge The content supplied to the server exceeds the maximum size
+
+swagger:response verifyTimestampResponseRequestEntityTooLarge
+*/
+type VerifyTimestampResponseRequestEntityTooLarge struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewVerifyTimestampResponseRequestEntityTooLarge creates VerifyTimestampResponseRequestEntityTooLarge with default headers values
+func NewVerifyTimestampResponseRequestEntityTooLarge() *VerifyTimestampResponseRequestEntityTooLarge {
+
+	return &VerifyTimestampResponseRequestEntityTooLarge{}
+}
+
+// WithPayload adds the payload to the verify timestamp response request entity too large response
+func (o *VerifyTimestampResponseRequestEntityTooLarge) WithPayload(payload *models.Error) *VerifyTimestampResponseRequestEntityTooLarge {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify timestamp response request entity too large response
+func (o *VerifyTimestampResponseRequestEntityTooLarge) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyTimestampResponseRequestEntityTooLarge) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(413)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VerifyTimestampResponseNotImplementedCode is the HTTP code returned for type VerifyTimestampResponseNotImplemented
+const VerifyTimestampResponseNotImplementedCode int = 501
+
+/*
+VerifyTimestampResponseNotImplemented The content requested is not implemented
+
+swagger:response verifyTimestampResponseNotImplemented
+*/
+type VerifyTimestampResponseNotImplemented struct {
+}
+
+// NewVerifyTimestampResponseNotImplemented creates VerifyTimestampResponseNotImplemented with default headers values
+func NewVerifyTimestampResponseNotImplemented() *VerifyTimestampResponseNotImplemented {
+
+	return &VerifyTimestampResponseNotImplemented{}
+}
+
+// WriteResponse to the client
+func (o *VerifyTimestampResponseNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(501)
+}
+
+/*
+VerifyTimestampResponseDefault There was an internal error in the server while processing the request
+
+swagger:response verifyTimestampResponseDefault
+*/
+type VerifyTimestampResponseDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewVerifyTimestampResponseDefault creates VerifyTimestampResponseDefault with default headers values
+func NewVerifyTimestampResponseDefault(code int) *VerifyTimestampResponseDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &VerifyTimestampResponseDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the verify timestamp response default response
+func (o *VerifyTimestampResponseDefault) WithStatusCode(code int) *VerifyTimestampResponseDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the verify timestamp response default response
+func (o *VerifyTimestampResponseDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the verify timestamp response default response
+func (o *VerifyTimestampResponseDefault) WithPayload(payload *models.Error) *VerifyTimestampResponseDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify timestamp response default response
+func (o *VerifyTimestampResponseDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyTimestampResponseDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -59,21 +59,49 @@ func NewTimestampServerAPI(spec *loads.Document) *TimestampServerAPI {
 		ApplicationTimestampQueryConsumer: runtime.ConsumerFunc(func(r io.Reader, target interface{}) error {
 			return errors.NotImplemented("applicationTimestampQuery consumer has not yet been implemented")
 		}),
-		JSONConsumer: runtime.JSONConsumer(),
+		BinConsumer:           runtime.ByteStreamConsumer(),
+		JSONConsumer:          runtime.JSONConsumer(),
+		MultipartformConsumer: runtime.DiscardConsumer,
 
 		ApplicationPemCertificateChainProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
 			return errors.NotImplemented("applicationPemCertificateChain producer has not yet been implemented")
 		}),
+		ApplicationPkcs7MimeProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("applicationPkcs7Mime producer has not yet been implemented")
+		}),
+		ApplicationPkixCertProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("applicationPkixCert producer has not yet been implemented")
+		}),
 		ApplicationTimestampReplyProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
 			return errors.NotImplemented("applicationTimestampReply producer has not yet been implemented")
 		}),
+		BinProducer:  runtime.ByteStreamProducer(),
+		JSONProducer: runtime.JSONProducer(),
 
+		TimestampGetAuthenticodeTimestampResponseHandler: timestamp.GetAuthenticodeTimestampResponseHandlerFunc(func(params timestamp.GetAuthenticodeTimestampResponseParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.GetAuthenticodeTimestampResponse has not yet been implemented")
+		}),
 		TimestampGetTimestampCertChainHandler: timestamp.GetTimestampCertChainHandlerFunc(func(params timestamp.GetTimestampCertChainParams) middleware.Responder {
 			return middleware.NotImplemented("operation timestamp.GetTimestampCertChain has not yet been implemented")
 		}),
+		TimestampGetTimestampInfoHandler: timestamp.GetTimestampInfoHandlerFunc(func(params timestamp.GetTimestampInfoParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.GetTimestampInfo has not yet been implemented")
+		}),
+		TimestampGetTimestampLinksHandler: timestamp.GetTimestampLinksHandlerFunc(func(params timestamp.GetTimestampLinksParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.GetTimestampLinks has not yet been implemented")
+		}),
 		TimestampGetTimestampResponseHandler: timestamp.GetTimestampResponseHandlerFunc(func(params timestamp.GetTimestampResponseParams) middleware.Responder {
 			return middleware.NotImplemented("operation timestamp.GetTimestampResponse has not yet been implemented")
 		}),
+		TimestampGetTimestampResponseForArtifactHandler: timestamp.GetTimestampResponseForArtifactHandlerFunc(func(params timestamp.GetTimestampResponseForArtifactParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.GetTimestampResponseForArtifact has not yet been implemented")
+		}),
+		TimestampGetTimestampTrustedRootHandler: timestamp.GetTimestampTrustedRootHandlerFunc(func(params timestamp.GetTimestampTrustedRootParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.GetTimestampTrustedRoot has not yet been implemented")
+		}),
+		TimestampVerifyTimestampResponseHandler: timestamp.VerifyTimestampResponseHandlerFunc(func(params timestamp.VerifyTimestampResponseParams) middleware.Responder {
+			return middleware.NotImplemented("operation timestamp.VerifyTimestampResponse has not yet been implemented")
+		}),
 	}
 }
 
@@ -105,21 +133,51 @@ type TimestampServerAPI struct {
 	// ApplicationTimestampQueryConsumer registers a consumer for the following mime types:
 	//   - application/timestamp-query
 	ApplicationTimestampQueryConsumer runtime.Consumer
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// MultipartformConsumer registers a consumer for the following mime types:
+	//   - multipart/form-data
+	MultipartformConsumer runtime.Consumer
 
 	// ApplicationPemCertificateChainProducer registers a producer for the following mime types:
 	//   - application/pem-certificate-chain
 	ApplicationPemCertificateChainProducer runtime.Producer
+	// ApplicationPkcs7MimeProducer registers a producer for the following mime types:
+	//   - application/pkcs7-mime
+	ApplicationPkcs7MimeProducer runtime.Producer
+	// ApplicationPkixCertProducer registers a producer for the following mime types:
+	//   - application/pkix-cert
+	ApplicationPkixCertProducer runtime.Producer
 	// ApplicationTimestampReplyProducer registers a producer for the following mime types:
 	//   - application/timestamp-reply
 	ApplicationTimestampReplyProducer runtime.Producer
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
+	// JSONProducer registers a producer for the following mime types:
+	//   - application/json
+	JSONProducer runtime.Producer
 
+	// TimestampGetAuthenticodeTimestampResponseHandler sets the operation handler for the get authenticode timestamp response operation
+	TimestampGetAuthenticodeTimestampResponseHandler timestamp.GetAuthenticodeTimestampResponseHandler
 	// TimestampGetTimestampCertChainHandler sets the operation handler for the get timestamp cert chain operation
 	TimestampGetTimestampCertChainHandler timestamp.GetTimestampCertChainHandler
+	// TimestampGetTimestampInfoHandler sets the operation handler for the get timestamp info operation
+	TimestampGetTimestampInfoHandler timestamp.GetTimestampInfoHandler
+	// TimestampGetTimestampLinksHandler sets the operation handler for the get timestamp links operation
+	TimestampGetTimestampLinksHandler timestamp.GetTimestampLinksHandler
 	// TimestampGetTimestampResponseHandler sets the operation handler for the get timestamp response operation
 	TimestampGetTimestampResponseHandler timestamp.GetTimestampResponseHandler
+	// TimestampGetTimestampResponseForArtifactHandler sets the operation handler for the get timestamp response for artifact operation
+	TimestampGetTimestampResponseForArtifactHandler timestamp.GetTimestampResponseForArtifactHandler
+	// TimestampGetTimestampTrustedRootHandler sets the operation handler for the get timestamp trusted root operation
+	TimestampGetTimestampTrustedRootHandler timestamp.GetTimestampTrustedRootHandler
+	// TimestampVerifyTimestampResponseHandler sets the operation handler for the verify timestamp response operation
+	TimestampVerifyTimestampResponseHandler timestamp.VerifyTimestampResponseHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -192,23 +250,59 @@ func (o *TimestampServerAPI) Validate() error {
 	if o.ApplicationTimestampQueryConsumer == nil {
 		unregistered = append(unregistered, "ApplicationTimestampQueryConsumer")
 	}
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.MultipartformConsumer == nil {
+		unregistered = append(unregistered, "MultipartformConsumer")
+	}
 
 	if o.ApplicationPemCertificateChainProducer == nil {
 		unregistered = append(unregistered, "ApplicationPemCertificateChainProducer")
 	}
+	if o.ApplicationPkcs7MimeProducer == nil {
+		unregistered = append(unregistered, "ApplicationPkcs7MimeProducer")
+	}
+	if o.ApplicationPkixCertProducer == nil {
+		unregistered = append(unregistered, "ApplicationPkixCertProducer")
+	}
 	if o.ApplicationTimestampReplyProducer == nil {
 		unregistered = append(unregistered, "ApplicationTimestampReplyProducer")
 	}
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+	if o.JSONProducer == nil {
+		unregistered = append(unregistered, "JSONProducer")
+	}
 
+	if o.TimestampGetAuthenticodeTimestampResponseHandler == nil {
+		unregistered = append(unregistered, "timestamp.GetAuthenticodeTimestampResponseHandler")
+	}
 	if o.TimestampGetTimestampCertChainHandler == nil {
 		unregistered = append(unregistered, "timestamp.GetTimestampCertChainHandler")
 	}
+	if o.TimestampGetTimestampInfoHandler == nil {
+		unregistered = append(unregistered, "timestamp.GetTimestampInfoHandler")
+	}
+	if o.TimestampGetTimestampLinksHandler == nil {
+		unregistered = append(unregistered, "timestamp.GetTimestampLinksHandler")
+	}
 	if o.TimestampGetTimestampResponseHandler == nil {
 		unregistered = append(unregistered, "timestamp.GetTimestampResponseHandler")
 	}
+	if o.TimestampGetTimestampResponseForArtifactHandler == nil {
+		unregistered = append(unregistered, "timestamp.GetTimestampResponseForArtifactHandler")
+	}
+	if o.TimestampGetTimestampTrustedRootHandler == nil {
+		unregistered = append(unregistered, "timestamp.GetTimestampTrustedRootHandler")
+	}
+	if o.TimestampVerifyTimestampResponseHandler == nil {
+		unregistered = append(unregistered, "timestamp.VerifyTimestampResponseHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -240,8 +334,12 @@ func (o *TimestampServerAPI) ConsumersFor(mediaTypes []string) map[string]runtim
 		switch mt {
 		case "application/timestamp-query":
 			result["application/timestamp-query"] = o.ApplicationTimestampQueryConsumer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "multipart/form-data":
+			result["multipart/form-data"] = o.MultipartformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -259,8 +357,16 @@ func (o *TimestampServerAPI) ProducersFor(mediaTypes []string) map[string]runtim
 		switch mt {
 		case "application/pem-certificate-chain":
 			result["application/pem-certificate-chain"] = o.ApplicationPemCertificateChainProducer
+		case "application/pkcs7-mime":
+			result["application/pkcs7-mime"] = o.ApplicationPkcs7MimeProducer
+		case "application/pkix-cert":
+			result["application/pkix-cert"] = o.ApplicationPkixCertProducer
 		case "application/timestamp-reply":
 			result["application/timestamp-reply"] = o.ApplicationTimestampReplyProducer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "application/json":
+			result["application/json"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -301,14 +407,38 @@ func (o *TimestampServerAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/timestamp/authenticode"] = timestamp.NewGetAuthenticodeTimestampResponse(o.context, o.TimestampGetAuthenticodeTimestampResponseHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/api/v1/timestamp/certchain"] = timestamp.NewGetTimestampCertChain(o.context, o.TimestampGetTimestampCertChainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/timestamp/info"] = timestamp.NewGetTimestampInfo(o.context, o.TimestampGetTimestampInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/timestamp/links"] = timestamp.NewGetTimestampLinks(o.context, o.TimestampGetTimestampLinksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/api/v1/timestamp"] = timestamp.NewGetTimestampResponse(o.context, o.TimestampGetTimestampResponseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/timestamp/artifact"] = timestamp.NewGetTimestampResponseForArtifact(o.context, o.TimestampGetTimestampResponseForArtifactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/timestamp/trustedroot"] = timestamp.NewGetTimestampTrustedRoot(o.context, o.TimestampGetTimestampTrustedRootHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/timestamp/verify"] = timestamp.NewVerifyTimestampResponse(o.context, o.TimestampVerifyTimestampResponseHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -15,9 +15,17 @@
 
 package cmdparams
 
+import "crypto/tls"
+
 // IsHTTPPingOnly is set off the command-line flag to enforce limiting
 // the non-mTLS http server to only serving the /ping entrypoint.
 // It should be set only once when processing command-line flags
 // and then used only in pkg/generated/restapi/configure_timestamp_server.go
 // and as read-only.
 var IsHTTPPingOnly bool
+
+// TLSConfig overrides the minimum version, client authentication and serving
+// certificate of the https listener when set. It should be set only once,
+// before the server starts, and then used only in
+// pkg/generated/restapi/configure_timestamp_server.go and as read-only.
+var TLSConfig *tls.Config
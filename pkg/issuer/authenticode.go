@@ -0,0 +1,285 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issuer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+)
+
+// oidAuthenticodeTimestampRequest identifies a request of the Authenticode
+// legacy timestamp protocol (SPC_TIME_STAMP_REQUEST_OBJID).
+var oidAuthenticodeTimestampRequest = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 3, 2, 1}
+
+var authenticodeDigestAlgorithms = map[crypto.Hash]asn1.ObjectIdentifier{
+	crypto.SHA256: pkcs7.OIDDigestAlgorithmSHA256,
+	crypto.SHA384: pkcs7.OIDDigestAlgorithmSHA384,
+	crypto.SHA512: pkcs7.OIDDigestAlgorithmSHA512,
+}
+
+// AuthenticodeRequest is a request of the Microsoft Authenticode legacy
+// timestamp protocol, as sent by signtool /t:
+//
+//	TimeStampRequest ::= SEQUENCE {
+//	  countersignatureType OBJECT IDENTIFIER,
+//	  attributes           Attributes OPTIONAL,
+//	  content              ContentInfo
+//	}
+type AuthenticodeRequest struct {
+	// Content is the data to countersign, the encrypted digest of the
+	// signature of the signed file.
+	Content []byte
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type issuerAndSerial struct {
+	IssuerName   asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type signerInfo struct {
+	Version                   int
+	IssuerAndSerialNumber     issuerAndSerial
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	AuthenticatedAttributes   asn1.RawValue
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      contentInfo
+	Certificates     asn1.RawValue
+	SignerInfos      []signerInfo `asn1:"set"`
+}
+
+type pkcs7Attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// ParseAuthenticodeRequest parses a DER-encoded Authenticode legacy
+// timestamp request. The protocol encodes requests in base64, which must be
+// decoded first.
+func ParseAuthenticodeRequest(der []byte) (*AuthenticodeRequest, error) {
+	var seq asn1.RawValue
+	rest, err := asn1.Unmarshal(der, &seq)
+	if err != nil {
+		return nil, fmt.Errorf("parsing authenticode request: %w", err)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after authenticode request")
+	}
+	if seq.Class != asn1.ClassUniversal || seq.Tag != asn1.TagSequence {
+		return nil, errors.New("authenticode request is not a sequence")
+	}
+
+	var oid asn1.ObjectIdentifier
+	fields, err := asn1.Unmarshal(seq.Bytes, &oid)
+	if err != nil {
+		return nil, fmt.Errorf("parsing authenticode request type: %w", err)
+	}
+	if !oid.Equal(oidAuthenticodeTimestampRequest) {
+		return nil, fmt.Errorf("unexpected authenticode request type %s", oid)
+	}
+
+	// the optional attributes precede the content, which is the last field
+	var field asn1.RawValue
+	for len(fields) > 0 {
+		if fields, err = asn1.Unmarshal(fields, &field); err != nil {
+			return nil, fmt.Errorf("parsing authenticode request: %w", err)
+		}
+	}
+	var ci struct {
+		ContentType asn1.ObjectIdentifier
+		Content     []byte `asn1:"explicit,tag:0"`
+	}
+	if _, err := asn1.Unmarshal(field.FullBytes, &ci); err != nil {
+		return nil, fmt.Errorf("parsing authenticode request content: %w", err)
+	}
+	if !ci.ContentType.Equal(pkcs7.OIDData) {
+		return nil, fmt.Errorf("unexpected authenticode content type %s", ci.ContentType)
+	}
+	if len(ci.Content) == 0 {
+		return nil, errors.New("authenticode request has no content")
+	}
+	return &AuthenticodeRequest{Content: ci.Content}, nil
+}
+
+// IssueAuthenticode countersigns the content of an Authenticode legacy
+// timestamp request, returning a DER-encoded PKCS#7 SignedData. Observers see
+// the request as a request for a timestamp of the digest of the content.
+func (i *Issuer) IssueAuthenticode(ctx context.Context, req *AuthenticodeRequest) (_ []byte, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "timestamp.issue_authenticode")
+	defer func() { tracing.End(span, err) }()
+
+	h := i.signerHash.New()
+	h.Write(req.Content)
+	e := &Event{
+		Request:       &timestamp.Request{HashAlgorithm: i.signerHash, HashedMessage: h.Sum(nil)},
+		SignerBackend: i.signerBackend,
+	}
+	resp, err := i.issueAuthenticode(ctx, req, e)
+	e.Err = err
+	if err = i.notify(ctx, e); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i *Issuer) issueAuthenticode(ctx context.Context, req *AuthenticodeRequest, e *Event) ([]byte, error) {
+	if err := i.checkRequest(ctx, e.Request, e); err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "timestamp.encode_response")
+	signer := &instrumentedSigner{Signer: i.signer, ctx: ctx, backend: i.signerBackend}
+	resp, err := i.countersign(signer, req.Content, e)
+	tracing.End(span, err)
+	e.SignDuration = signer.elapsed
+	if err != nil {
+		return nil, newError(timestamp.SystemFailure, "Error generating timestamp response", err)
+	}
+	return resp, nil
+}
+
+// countersign creates the PKCS#7 SignedData of content, with the signing
+// time and digest recorded in e as authenticated attributes.
+func (i *Issuer) countersign(signer crypto.Signer, content []byte, e *Event) ([]byte, error) {
+	leaf := i.certChain[0]
+	digestAlgorithm, ok := authenticodeDigestAlgorithms[i.signerHash]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signer hash %v", i.signerHash)
+	}
+	signatureAlgorithm, err := authenticodeSignatureAlgorithm(leaf.PublicKey, i.signerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := authenticatedAttributes(e)
+	if err != nil {
+		return nil, err
+	}
+	var signature []byte
+	if _, ok := leaf.PublicKey.(ed25519.PublicKey); ok {
+		signature, err = signer.Sign(rand.Reader, attrs, crypto.Hash(0))
+	} else {
+		h := i.signerHash.New()
+		h.Write(attrs)
+		signature, err = signer.Sign(rand.Reader, h.Sum(nil), i.signerHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the attributes are signed as a SET OF, and encoded as [0] IMPLICIT
+	implicitAttrs := append([]byte{0xa0}, attrs[1:]...)
+
+	data, err := asn1.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	var certs []byte
+	for _, c := range i.certChain {
+		certs = append(certs, c.Raw...)
+	}
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: digestAlgorithm}},
+		ContentInfo:      contentInfo{ContentType: pkcs7.OIDData, Content: explicitContent(data)},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certs},
+		SignerInfos: []signerInfo{{
+			Version: 1,
+			IssuerAndSerialNumber: issuerAndSerial{
+				IssuerName:   asn1.RawValue{FullBytes: leaf.RawIssuer},
+				SerialNumber: leaf.SerialNumber,
+			},
+			DigestAlgorithm:           pkix.AlgorithmIdentifier{Algorithm: digestAlgorithm},
+			AuthenticatedAttributes:   asn1.RawValue{FullBytes: implicitAttrs},
+			DigestEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: signatureAlgorithm},
+			EncryptedDigest:           signature,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{ContentType: pkcs7.OIDSignedData, Content: explicitContent(sd)})
+}
+
+// authenticatedAttributes returns the DER-encoded SET OF the content type,
+// signing time and message digest attributes.
+func authenticatedAttributes(e *Event) ([]byte, error) {
+	var attrs []pkcs7Attribute
+	for _, a := range []struct {
+		oid   asn1.ObjectIdentifier
+		value any
+	}{
+		{pkcs7.OIDAttributeContentType, pkcs7.OIDData},
+		{pkcs7.OIDAttributeSigningTime, e.GenTime},
+		{pkcs7.OIDAttributeMessageDigest, e.Request.HashedMessage},
+	} {
+		der, err := asn1.Marshal(a.value)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, pkcs7Attribute{Type: a.oid, Values: []asn1.RawValue{{FullBytes: der}}})
+	}
+	return asn1.MarshalWithParams(attrs, "set")
+}
+
+func explicitContent(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+// authenticodeSignatureAlgorithm returns the digest encryption algorithm of
+// a signer info for the public key, using rsaEncryption for RSA keys as
+// legacy Windows verifiers expect.
+func authenticodeSignatureAlgorithm(pub crypto.PublicKey, h crypto.Hash) (asn1.ObjectIdentifier, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return pkcs7.OIDEncryptionAlgorithmRSA, nil
+	case *ecdsa.PublicKey:
+		switch h {
+		case crypto.SHA256:
+			return pkcs7.OIDDigestAlgorithmECDSASHA256, nil
+		case crypto.SHA384:
+			return pkcs7.OIDDigestAlgorithmECDSASHA384, nil
+		case crypto.SHA512:
+			return pkcs7.OIDDigestAlgorithmECDSASHA512, nil
+		}
+	case ed25519.PublicKey:
+		return pkcs7.OIDEncryptionAlgorithmEDDSA25519, nil
+	}
+	return nil, fmt.Errorf("unsupported signer key %T with hash %v", pub, h)
+}
@@ -0,0 +1,81 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issuer
+
+import (
+	"fmt"
+
+	"github.com/digitorus/timestamp"
+)
+
+// Error is returned when a timestamp request is rejected.
+type Error struct {
+	// FailureInfo is the RFC 3161 reason for the rejection.
+	FailureInfo timestamp.FailureInfo
+	// Message is safe to return to the client.
+	Message string
+	// Err is the underlying cause.
+	Err error
+}
+
+func newError(fi timestamp.FailureInfo, message string, err error) *Error {
+	return &Error{FailureInfo: fi, Message: message, Err: err}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Message, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// IsClientError reports whether the rejection was caused by the request
+// rather than by the issuer.
+func (e *Error) IsClientError() bool {
+	switch e.FailureInfo {
+	case timestamp.BadAlgorithm, timestamp.BadRequest, timestamp.BadDataFormat,
+		timestamp.UnacceptedPolicy, timestamp.UnacceptedExtension:
+		return true
+	default:
+		return false
+	}
+}
+
+// Reason returns the RFC 3161 PKIFailureInfo name of the rejection, such as
+// badAlg or unacceptedPolicy.
+func (e *Error) Reason() string {
+	switch e.FailureInfo {
+	case timestamp.BadAlgorithm:
+		return "badAlg"
+	case timestamp.BadRequest:
+		return "badRequest"
+	case timestamp.BadDataFormat:
+		return "badDataFormat"
+	case timestamp.TimeNotAvailable:
+		return "timeNotAvailable"
+	case timestamp.UnacceptedPolicy:
+		return "unacceptedPolicy"
+	case timestamp.UnacceptedExtension:
+		return "unacceptedExtension"
+	case timestamp.AddInfoNotAvailable:
+		return "addInfoNotAvailable"
+	case timestamp.SystemFailure:
+		return "systemFailure"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issuer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+const (
+	timestampQueryMediaType = "application/timestamp-query"
+	timestampReplyMediaType = "application/timestamp-reply"
+)
+
+// MaxRequestSize bounds the size of a DER-encoded TimeStampReq. Requests only
+// carry a message imprint, so anything larger is malformed.
+const MaxRequestSize = 1 << 16
+
+// NewHandler returns an http.Handler serving the RFC 3161 HTTP transport for
+// the issuer: a POST with a DER-encoded TimeStampReq is answered with a
+// DER-encoded TimeStampResp. Rejected requests are answered with a
+// TimeStampResp carrying the failure reason.
+func NewHandler(i *Issuer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != timestampQueryMediaType {
+			http.Error(w, "expected content type "+timestampQueryMediaType, http.StatusUnsupportedMediaType)
+			return
+		}
+
+		ctx := RequestContext(r)
+		body, err := io.ReadAll(io.LimitReader(r.Body, MaxRequestSize+1))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		if len(body) > MaxRequestSize {
+			ierr := i.Reject(ctx, timestamp.BadRequest, "Timestamp request is too large",
+				fmt.Errorf("request exceeds %d bytes", MaxRequestSize))
+			writeRejection(w, r, ierr.FailureInfo)
+			return
+		}
+
+		resp, err := i.IssueDER(ctx, body)
+		if err != nil {
+			fi := timestamp.SystemFailure
+			var ierr *Error
+			if errors.As(err, &ierr) {
+				fi = ierr.FailureInfo
+			}
+			if ierr != nil && ierr.IsClientError() {
+				log.RequestIDLogger(r).Debugf("rejecting timestamp request: %v", err)
+			} else {
+				log.RequestIDLogger(r).Errorw("rejecting timestamp request", "error", err)
+			}
+			writeRejection(w, r, fi)
+			return
+		}
+
+		w.Header().Set("Content-Type", timestampReplyMediaType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(resp) //nolint:errcheck
+	})
+}
+
+func writeRejection(w http.ResponseWriter, r *http.Request, fi timestamp.FailureInfo) {
+	resp, err := timestamp.CreateErrorResponse(timestamp.Rejection, fi)
+	if err != nil {
+		log.RequestIDLogger(r).Errorw("creating timestamp error response", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", timestampReplyMediaType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp) //nolint:errcheck
+}
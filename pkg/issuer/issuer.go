@@ -0,0 +1,538 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package issuer implements an RFC 3161 timestamp issuer that can be embedded
+// in other services. An Issuer holds no global state and is configured only
+// through Options, so several issuers may run in a single process.
+package issuer
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
+)
+
+var (
+	// DefaultPolicy is the TSA policy OID used when a request does not ask for one.
+	DefaultPolicy = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2}
+	// DefaultAccuracy is the accuracy of the generation time in issued timestamps.
+	DefaultAccuracy = time.Second
+	// DefaultMaxClockCorrection caps the correction applied to the generation time.
+	DefaultMaxClockCorrection = 500 * time.Millisecond
+
+	errVerifyFailed = errors.New("a signed response failed verification")
+)
+
+// Clock provides the current time used as the generation time of timestamps.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock that returns the local system time.
+type SystemClock struct{}
+
+// Now returns the local system time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ClockOffset provides the estimated offset of the local clock from a
+// reference, such as the consensus of NTP servers.
+type ClockOffset interface {
+	// Offset returns the offset to be added to the local time, or false
+	// while none is known.
+	Offset() (time.Duration, bool)
+}
+
+// Options configures an Issuer.
+type Options struct {
+	// Signer signs timestamp responses. Required.
+	Signer crypto.Signer
+	// SignerHash is the hash algorithm used to hash the signed attributes. Defaults to SHA-256.
+	SignerHash crypto.Hash
+	// CertChain is the timestamping certificate chain, starting with the leaf
+	// certificate for Signer and ending with the root. Required.
+	CertChain []*x509.Certificate
+	// DefaultPolicy is used when a request does not specify a policy. Defaults to DefaultPolicy.
+	DefaultPolicy asn1.ObjectIdentifier
+	// AcceptedPolicies lists additional policies a request may ask for. If empty,
+	// any requested policy is accepted.
+	AcceptedPolicies []asn1.ObjectIdentifier
+	// RequireCertificatePolicy only issues timestamps under policies listed in
+	// the certificatePolicies extension of the leaf certificate. New fails if
+	// the default policy or an accepted policy is not listed.
+	RequireCertificatePolicy bool
+	// Accuracy of the generation time. Defaults to DefaultAccuracy.
+	Accuracy time.Duration
+	// Clock provides the generation time. Defaults to SystemClock.
+	Clock Clock
+	// ClockOffset corrects the time of Clock. The time of Clock is used
+	// uncorrected while no offset is known. Optional.
+	ClockOffset ClockOffset
+	// MaxClockCorrection caps the correction applied from ClockOffset.
+	// Defaults to DefaultMaxClockCorrection.
+	MaxClockCorrection time.Duration
+	// ExpiryWarningWindow is the duration before the first certificate in the
+	// chain expires during which the issuer warns about expiry. Optional.
+	ExpiryWarningWindow time.Duration
+	// FailReadinessOnExpiryWarning makes Ready fail while inside the expiry warning window.
+	FailReadinessOnExpiryWarning bool
+	// Observers are notified of the outcome of every request. A Recorder among
+	// them withholds a timestamp it cannot record. Optional.
+	Observers []Observer
+	// SignerBackend names the kind of Signer, such as kms or memory, in traces.
+	// Defaults to the type of Signer.
+	SignerBackend string
+	// VerifyAfterSign verifies every response against the leaf certificate and
+	// the request before returning it, failing with systemFailure if it does not verify.
+	VerifyAfterSign bool
+	// TripOnVerifyFailure stops the issuer from signing, and makes Ready fail,
+	// once a response fails verification. Requires VerifyAfterSign.
+	TripOnVerifyFailure bool
+	// Linker links every timestamp to those issued before it. Timestamps are
+	// then issued one at a time. Optional.
+	Linker Linker
+}
+
+// Linker links each issued timestamp to those issued before it, such as a
+// linking.Chain.
+type Linker interface {
+	// Link calls issue with the extension linking the next timestamp, and
+	// records the DER-encoded TimeStampToken it returns. Calls are serialized.
+	Link(issue func(ext pkix.Extension) (token []byte, err error)) error
+}
+
+// Issuer issues RFC 3161 timestamp responses.
+type Issuer struct {
+	signer           crypto.Signer
+	signerHash       crypto.Hash
+	certChain        []*x509.Certificate
+	defaultPolicy    asn1.ObjectIdentifier
+	acceptedPolicies []asn1.ObjectIdentifier
+	requirePolicy    bool
+	accuracy         time.Duration
+	clock            Clock
+	clockOffset      ClockOffset
+	maxCorrection    time.Duration
+	observers        []Observer
+	signerBackend    string
+	linker           Linker
+
+	verifyAfterSign     bool
+	tripOnVerifyFailure bool
+	tripped             atomic.Pointer[error]
+
+	expiryWarningWindow          time.Duration
+	failReadinessOnExpiryWarning bool
+	expiryWarning                atomic.Bool
+}
+
+// New creates an Issuer from the provided options.
+func New(opts Options) (*Issuer, error) {
+	if opts.Signer == nil {
+		return nil, errors.New("signer must be provided")
+	}
+	if len(opts.CertChain) == 0 {
+		return nil, errors.New("certificate chain must be provided")
+	}
+	if opts.MaxClockCorrection < 0 {
+		return nil, errors.New("maximum clock correction must not be negative")
+	}
+
+	i := &Issuer{
+		signer:                       opts.Signer,
+		signerHash:                   opts.SignerHash,
+		certChain:                    opts.CertChain,
+		defaultPolicy:                opts.DefaultPolicy,
+		acceptedPolicies:             opts.AcceptedPolicies,
+		requirePolicy:                opts.RequireCertificatePolicy,
+		accuracy:                     opts.Accuracy,
+		clock:                        opts.Clock,
+		clockOffset:                  opts.ClockOffset,
+		maxCorrection:                opts.MaxClockCorrection,
+		observers:                    opts.Observers,
+		signerBackend:                opts.SignerBackend,
+		linker:                       opts.Linker,
+		verifyAfterSign:              opts.VerifyAfterSign,
+		tripOnVerifyFailure:          opts.TripOnVerifyFailure,
+		expiryWarningWindow:          opts.ExpiryWarningWindow,
+		failReadinessOnExpiryWarning: opts.FailReadinessOnExpiryWarning,
+	}
+	if i.signerHash == 0 {
+		i.signerHash = crypto.SHA256
+	}
+	if len(i.defaultPolicy) == 0 {
+		i.defaultPolicy = DefaultPolicy
+	}
+	if i.requirePolicy {
+		for _, p := range append([]asn1.ObjectIdentifier{i.defaultPolicy}, i.acceptedPolicies...) {
+			if err := tsx509.VerifyCertificatePolicy(i.certChain[0], p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if i.accuracy == 0 {
+		i.accuracy = DefaultAccuracy
+	}
+	if i.clock == nil {
+		i.clock = SystemClock{}
+	}
+	if i.maxCorrection == 0 {
+		i.maxCorrection = DefaultMaxClockCorrection
+	}
+	if i.signerBackend == "" {
+		i.signerBackend = fmt.Sprintf("%T", i.signer)
+	}
+	_ = i.CheckCertificateExpiry()
+
+	return i, nil
+}
+
+// CertChain returns the timestamping certificate chain.
+func (i *Issuer) CertChain() []*x509.Certificate {
+	return i.certChain
+}
+
+// DefaultPolicy returns the policy used when a request does not specify one.
+func (i *Issuer) DefaultPolicy() asn1.ObjectIdentifier {
+	return i.defaultPolicy
+}
+
+// AcceptedPolicies returns the policies a request may ask for in addition to
+// the default policy. An empty list means any policy is accepted.
+func (i *Issuer) AcceptedPolicies() []asn1.ObjectIdentifier {
+	return i.acceptedPolicies
+}
+
+// Accuracy returns the accuracy of the generation time of issued timestamps.
+func (i *Issuer) Accuracy() time.Duration {
+	return i.accuracy
+}
+
+// Issue creates a signed timestamp response for the request, returning the
+// DER-encoded TimeStampResp. When the request cannot be granted, the returned
+// error is an *Error holding the RFC 3161 failure reason.
+func (i *Issuer) Issue(ctx context.Context, req *timestamp.Request) (_ []byte, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "timestamp.issue")
+	defer func() { tracing.End(span, err) }()
+
+	e := &Event{Request: req, SignerBackend: i.signerBackend}
+	resp, err := i.issue(ctx, req, e)
+	if err == nil && len(i.observers) > 0 {
+		// the serial number is generated while creating the response
+		if ts, perr := timestamp.ParseResponse(resp); perr == nil {
+			e.SerialNumber = ts.SerialNumber
+		}
+	}
+	e.Err = err
+	if err = i.notify(ctx, e); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// IssueDER parses a DER-encoded TimeStampReq and issues a timestamp for it,
+// like Issue. A request that cannot be parsed is rejected with BadDataFormat,
+// so that every transport sees the same *Error for the same request.
+func (i *Issuer) IssueDER(ctx context.Context, der []byte) ([]byte, error) {
+	_, span := tracing.Tracer().Start(ctx, "timestamp.parse_request",
+		trace.WithAttributes(attribute.String("tsa.request.content_type", "timestamp-query")))
+	req, err := timestamp.ParseRequest(der)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, i.Reject(ctx, timestamp.BadDataFormat, "Invalid timestamp request", err)
+	}
+	return i.Issue(ctx, req)
+}
+
+// issue creates the response, recording its details in e.
+func (i *Issuer) issue(ctx context.Context, req *timestamp.Request, e *Event) ([]byte, error) {
+	if err := i.checkRequest(ctx, req, e); err != nil {
+		return nil, err
+	}
+	if i.linker == nil {
+		return i.sign(ctx, req, e, nil)
+	}
+
+	var resp []byte
+	err := i.linker.Link(func(ext pkix.Extension) ([]byte, error) {
+		// the generation time follows the order of the chain
+		if err := i.setGenTime(e); err != nil {
+			return nil, err
+		}
+		r, err := i.sign(ctx, req, e, &ext)
+		if err != nil {
+			return nil, err
+		}
+		ts, err := timestamp.ParseResponse(r)
+		if err != nil {
+			return nil, newError(timestamp.SystemFailure, "Error generating timestamp response", err)
+		}
+		resp = r
+		return ts.RawToken, nil
+	})
+	if err != nil {
+		var ierr *Error
+		if !errors.As(err, &ierr) {
+			err = newError(timestamp.SystemFailure, "Error linking timestamp response", err)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sign creates and signs the response, adding the link extension if any.
+func (i *Issuer) sign(ctx context.Context, req *timestamp.Request, e *Event, link *pkix.Extension) ([]byte, error) {
+	tsStruct := timestamp.Timestamp{
+		HashAlgorithm: req.HashAlgorithm,
+		HashedMessage: req.HashedMessage,
+		Time:          e.GenTime,
+		Nonce:         req.Nonce,
+		Policy:        e.Policy,
+		Ordering:      false,
+		Accuracy:      i.accuracy,
+		// Not qualified for the european directive
+		Qualified:         false,
+		AddTSACertificate: req.Certificates,
+		ExtraExtensions:   req.Extensions,
+	}
+	if link != nil {
+		tsStruct.ExtraExtensions = append(slices.Clone(req.Extensions), *link)
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "timestamp.encode_response")
+	signer := &instrumentedSigner{Signer: i.signer, ctx: ctx, backend: i.signerBackend}
+	resp, err := tsStruct.CreateResponseWithOpts(i.certChain[0], signer, i.signerHash)
+	tracing.End(span, err)
+	e.SignDuration += signer.elapsed
+	if err != nil {
+		return nil, newError(timestamp.SystemFailure, "Error generating timestamp response", err)
+	}
+	if i.verifyAfterSign {
+		if err := i.verifyResponse(ctx, resp, req, e); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// verifyResponse verifies a signed response before it is returned, so that a
+// faulty signature from the signer is never handed to a client.
+func (i *Issuer) verifyResponse(ctx context.Context, resp []byte, req *timestamp.Request, e *Event) (err error) {
+	_, span := tracing.Tracer().Start(ctx, "timestamp.verify_response")
+	defer func() { tracing.End(span, err) }()
+
+	if err := verification.VerifyIssuedResponse(resp, req, i.certChain[0]); err != nil {
+		e.VerifyFailed = true
+		log.Logger.Errorf("SIGNATURE VERIFICATION FAILURE: %v", err)
+		if i.tripOnVerifyFailure {
+			i.Trip(errVerifyFailed)
+		}
+		return newError(timestamp.SystemFailure, "Error generating timestamp response", err)
+	}
+	return nil
+}
+
+// checkRequest checks the request and the requested policy, recording the
+// policy and generation time in e.
+func (i *Issuer) checkRequest(ctx context.Context, req *timestamp.Request, e *Event) (err error) {
+	_, span := tracing.Tracer().Start(ctx, "timestamp.check_request", trace.WithAttributes(
+		attribute.String("tsa.hash_algorithm", req.HashAlgorithm.String()),
+		attribute.String("tsa.requested_policy", req.TSAPolicyOID.String()),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if err := verification.VerifyRequest(req); err != nil {
+		return newError(timestamp.BadAlgorithm, "Weak hash algorithm in timestamp request", err)
+	}
+	if !req.HashAlgorithm.Available() {
+		return newError(timestamp.BadAlgorithm, "Unsupported hash algorithm in timestamp request",
+			fmt.Errorf("unsupported hash algorithm: %v", req.HashAlgorithm))
+	}
+	if len(req.HashedMessage) != req.HashAlgorithm.Size() {
+		return newError(timestamp.BadDataFormat, "Message imprint does not match the hash algorithm",
+			fmt.Errorf("expected %d byte message imprint, got %d", req.HashAlgorithm.Size(), len(req.HashedMessage)))
+	}
+	// the link of a timestamp is only ever set by the issuer
+	for _, ext := range req.Extensions {
+		if ext.Id.Equal(linking.OID) {
+			return newError(timestamp.BadRequest, "Timestamp request carries the linking extension",
+				errors.New("request extensions must not include the linking extension"))
+		}
+	}
+
+	policy, err := i.policyFor(req.TSAPolicyOID)
+	if err != nil {
+		return newError(timestamp.UnacceptedPolicy, "Requested policy is not accepted", err)
+	}
+	// New checked the configured policies, but any policy is accepted when none are listed
+	if i.requirePolicy {
+		if err := tsx509.VerifyCertificatePolicy(i.certChain[0], policy); err != nil {
+			return newError(timestamp.UnacceptedPolicy, "Requested policy is not authorized by the timestamping certificate", err)
+		}
+	}
+	e.Policy = policy
+	span.SetAttributes(attribute.String("tsa.policy", policy.String()))
+
+	if err := i.setGenTime(e); err != nil {
+		return err
+	}
+	if i.clockOffset != nil {
+		span.SetAttributes(attribute.String("tsa.clock_correction", e.GenTime.Sub(e.LocalTime).String()))
+	}
+	return nil
+}
+
+// setGenTime records the generation time in e, checking that the timestamp
+// could be verified against the chain at that time.
+func (i *Issuer) setGenTime(e *Event) error {
+	// The field here is going to be serialized as a GeneralizedTime, and RFC5280
+	// states that the GeneralizedTime values MUST be expressed in Greenwich Mean Time.
+	// However, go asn1/marshal will happily accept other formats. So we force it directly here.
+	// https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.5.2
+	localTime := i.clock.Now().UTC()
+	genTime := localTime
+	if i.clockOffset != nil {
+		genTime = CorrectTime(localTime, i.clockOffset, i.maxCorrection)
+	}
+	e.LocalTime, e.GenTime = localTime, genTime
+
+	// Refuse to issue a timestamp that could not be verified against the chain
+	if err := tsx509.VerifyCertChainValidity(i.certChain, genTime); err != nil {
+		return newError(timestamp.SystemFailure, "Timestamping certificate chain is not valid at the time of issuance", err)
+	}
+	if err := i.trippedErr(); err != nil {
+		return newError(timestamp.SystemFailure, "Timestamping is suspended", err)
+	}
+	_ = i.checkCertificateExpiry(genTime)
+	return nil
+}
+
+// CorrectTime adds the offset of the local clock, capped to maxCorrection, to
+// a time of the local clock. The time is returned unchanged while no offset
+// is known, or if maxCorrection is not positive.
+func CorrectTime(local time.Time, offset ClockOffset, maxCorrection time.Duration) time.Time {
+	d, ok := offset.Offset()
+	if !ok || maxCorrection <= 0 {
+		return local
+	}
+	return local.Add(max(-maxCorrection, min(d, maxCorrection)))
+}
+
+// instrumentedSigner records a span for every signature, as crypto.Signer
+// does not carry a context, and the time spent signing.
+type instrumentedSigner struct {
+	crypto.Signer
+	ctx     context.Context
+	backend string
+	elapsed time.Duration
+}
+
+func (s *instrumentedSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) (_ []byte, err error) {
+	_, span := tracing.Tracer().Start(s.ctx, "timestamp.sign", trace.WithAttributes(
+		attribute.String("tsa.signer.backend", s.backend),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	defer func() { s.elapsed += time.Since(start) }()
+	return s.Signer.Sign(rand, digest, opts)
+}
+
+// policyFor returns the policy to use for a request asking for the given policy.
+func (i *Issuer) policyFor(requested asn1.ObjectIdentifier) (asn1.ObjectIdentifier, error) {
+	if len(requested) == 0 {
+		return i.defaultPolicy, nil
+	}
+	if len(i.acceptedPolicies) == 0 || requested.Equal(i.defaultPolicy) {
+		return requested, nil
+	}
+	for _, p := range i.acceptedPolicies {
+		if requested.Equal(p) {
+			return requested, nil
+		}
+	}
+	return nil, fmt.Errorf("policy %s is not accepted", requested.String())
+}
+
+// CheckCertificateExpiry returns an error while the first certificate in the
+// chain to expire is within the expiry warning window.
+func (i *Issuer) CheckCertificateExpiry() error {
+	return i.checkCertificateExpiry(i.clock.Now())
+}
+
+// checkCertificateExpiry logs the first time the expiry warning window is entered.
+func (i *Issuer) checkCertificateExpiry(now time.Time) error {
+	if i.expiryWarningWindow <= 0 {
+		return nil
+	}
+	cert := tsx509.EarliestExpiry(i.certChain)
+	if cert.NotAfter.Sub(now) > i.expiryWarningWindow {
+		i.expiryWarning.Store(false)
+		return nil
+	}
+
+	err := fmt.Errorf("certificate %q expires at %s, within the warning window of %s",
+		cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339), i.expiryWarningWindow)
+	if !i.expiryWarning.Swap(true) {
+		log.Logger.Errorf("CERTIFICATE EXPIRY WARNING: %v", err)
+	}
+	return err
+}
+
+// Trip stops the issuer from signing, and makes Ready fail, until it is
+// replaced, such as when its timestamps can no longer be trusted or recorded.
+func (i *Issuer) Trip(reason error) {
+	if i.tripped.CompareAndSwap(nil, &reason) {
+		log.Logger.Errorf("signing stopped until the issuer is replaced: %v", reason)
+	}
+}
+
+// trippedErr returns the error signing stopped with, if any.
+func (i *Issuer) trippedErr() error {
+	if reason := i.tripped.Load(); reason != nil {
+		return fmt.Errorf("signing stopped: %w", *reason)
+	}
+	return nil
+}
+
+// Ready returns an error if the issuer should not receive traffic.
+func (i *Issuer) Ready() error {
+	if err := i.trippedErr(); err != nil {
+		return err
+	}
+	if err := i.CheckCertificateExpiry(); err != nil && i.failReadinessOnExpiryWarning {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,583 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issuer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func newTestIssuer(t *testing.T, opts Options) (*Issuer, []*x509.Certificate) {
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, leafKey, _ := testutils.GenerateLeafCert(subCert, subKey)
+	chain := []*x509.Certificate{leafCert, subCert, rootCert}
+
+	opts.Signer = leafKey
+	opts.CertChain = chain
+	i, err := New(opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	return i, chain
+}
+
+func newTestRequest(policy asn1.ObjectIdentifier) *timestamp.Request {
+	digest := sha256.Sum256([]byte("blob"))
+	return &timestamp.Request{
+		HashAlgorithm: crypto.SHA256,
+		HashedMessage: digest[:],
+		TSAPolicyOID:  policy,
+		Certificates:  true,
+	}
+}
+
+func TestNewRequiresSignerAndChain(t *testing.T) {
+	if _, err := New(Options{}); err == nil {
+		t.Fatal("expected error creating issuer without signer")
+	}
+	_, leafKey, _ := testutils.GenerateRootCa()
+	if _, err := New(Options{Signer: leafKey}); err == nil {
+		t.Fatal("expected error creating issuer without certificate chain")
+	}
+}
+
+func TestIssue(t *testing.T) {
+	now := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+	i, chain := newTestIssuer(t, Options{Clock: fixedClock(now)})
+
+	// a second, independent issuer in the same process
+	other, otherChain := newTestIssuer(t, Options{})
+
+	for _, tc := range []struct {
+		issuer *Issuer
+		chain  []*x509.Certificate
+	}{{i, chain}, {other, otherChain}} {
+		resp, err := tc.issuer.Issue(context.Background(), newTestRequest(nil))
+		if err != nil {
+			t.Fatalf("unexpected error issuing timestamp: %v", err)
+		}
+		ts, err := verification.VerifyTimestampResponse(resp, bytes.NewReader([]byte("blob")), verification.VerifyOpts{
+			Roots:         []*x509.Certificate{tc.chain[2]},
+			Intermediates: []*x509.Certificate{tc.chain[1]},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error verifying timestamp: %v", err)
+		}
+		if !ts.Policy.Equal(DefaultPolicy) {
+			t.Fatalf("expected default policy, got %v", ts.Policy)
+		}
+		if ts.Accuracy != DefaultAccuracy {
+			t.Fatalf("expected default accuracy, got %v", ts.Accuracy)
+		}
+	}
+
+	resp, _ := i.Issue(context.Background(), newTestRequest(nil))
+	ts, err := timestamp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("unexpected error parsing timestamp: %v", err)
+	}
+	if !ts.Time.Equal(now) {
+		t.Fatalf("expected generation time %v from clock, got %v", now, ts.Time)
+	}
+}
+
+type fixedOffset struct {
+	offset time.Duration
+	ok     bool
+}
+
+func (o *fixedOffset) Offset() (time.Duration, bool) {
+	return o.offset, o.ok
+}
+
+func TestIssueCorrectsClock(t *testing.T) {
+	now := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+	var events []*Event
+	observer := ObserverFunc(func(_ context.Context, e *Event) {
+		events = append(events, e)
+	})
+	offset := &fixedOffset{}
+	i, _ := newTestIssuer(t, Options{Clock: fixedClock(now), ClockOffset: offset, Observers: []Observer{observer}})
+
+	for _, tc := range []struct {
+		offset   time.Duration
+		ok       bool
+		expected time.Duration
+	}{
+		{200 * time.Millisecond, false, 0},
+		{200 * time.Millisecond, true, 200 * time.Millisecond},
+		{-300 * time.Millisecond, true, -300 * time.Millisecond},
+		// capped to DefaultMaxClockCorrection
+		{2 * time.Second, true, DefaultMaxClockCorrection},
+		{-time.Hour, true, -DefaultMaxClockCorrection},
+	} {
+		offset.offset, offset.ok = tc.offset, tc.ok
+		resp, err := i.Issue(context.Background(), newTestRequest(nil))
+		if err != nil {
+			t.Fatalf("unexpected error issuing timestamp: %v", err)
+		}
+		ts, err := timestamp.ParseResponse(resp)
+		if err != nil {
+			t.Fatalf("unexpected error parsing timestamp: %v", err)
+		}
+		e := events[len(events)-1]
+		if expected := now.Add(tc.expected); !e.GenTime.Equal(expected) || !e.LocalTime.Equal(now) {
+			t.Errorf("offset %v: expected generation time %v from local time %v, got %v from %v", tc.offset, expected, now, e.GenTime, e.LocalTime)
+		}
+		// the generation time is encoded in whole seconds
+		if !ts.Time.Equal(e.GenTime.Truncate(time.Second)) {
+			t.Errorf("offset %v: expected encoded generation time %v, got %v", tc.offset, e.GenTime, ts.Time)
+		}
+	}
+
+	// a negative cap would shift the generation time even without an offset
+	if _, err := New(Options{Signer: i.signer, CertChain: i.certChain, ClockOffset: offset, MaxClockCorrection: -time.Second}); err == nil {
+		t.Fatal("expected error for a negative maximum clock correction")
+	}
+}
+
+func TestCorrectTime(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		offset        fixedOffset
+		maxCorrection time.Duration
+		expected      time.Duration
+	}{
+		{fixedOffset{time.Second, false}, time.Minute, 0},
+		{fixedOffset{time.Second, true}, time.Minute, time.Second},
+		{fixedOffset{-time.Hour, true}, time.Minute, -time.Minute},
+		{fixedOffset{0, true}, -time.Minute, 0},
+		{fixedOffset{time.Second, true}, 0, 0},
+	} {
+		if got := CorrectTime(now, &tc.offset, tc.maxCorrection); !got.Equal(now.Add(tc.expected)) {
+			t.Errorf("offset %+v capped to %v: expected a correction of %v, got %v", tc.offset, tc.maxCorrection, tc.expected, got.Sub(now))
+		}
+	}
+}
+
+func TestIssuePolicies(t *testing.T) {
+	defaultPolicy := asn1.ObjectIdentifier{1, 2, 3}
+	accepted := asn1.ObjectIdentifier{1, 2, 4}
+	i, _ := newTestIssuer(t, Options{
+		DefaultPolicy:    defaultPolicy,
+		AcceptedPolicies: []asn1.ObjectIdentifier{accepted},
+	})
+
+	for _, tc := range []struct {
+		requested asn1.ObjectIdentifier
+		expected  asn1.ObjectIdentifier
+	}{
+		{nil, defaultPolicy},
+		{defaultPolicy, defaultPolicy},
+		{accepted, accepted},
+	} {
+		resp, err := i.Issue(context.Background(), newTestRequest(tc.requested))
+		if err != nil {
+			t.Fatalf("unexpected error issuing timestamp: %v", err)
+		}
+		ts, err := timestamp.ParseResponse(resp)
+		if err != nil {
+			t.Fatalf("unexpected error parsing timestamp: %v", err)
+		}
+		if !ts.Policy.Equal(tc.expected) {
+			t.Fatalf("expected policy %v, got %v", tc.expected, ts.Policy)
+		}
+	}
+
+	_, err := i.Issue(context.Background(), newTestRequest(asn1.ObjectIdentifier{1, 2, 5}))
+	var ierr *Error
+	if !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.UnacceptedPolicy || !ierr.IsClientError() {
+		t.Fatalf("expected unaccepted policy error, got %v", err)
+	}
+}
+
+func TestRequireCertificatePolicy(t *testing.T) {
+	authorized := asn1.ObjectIdentifier{1, 2, 3}
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, leafKey, _ := testutils.GenerateLeafCertWithPolicies(subCert, subKey, authorized)
+	opts := Options{
+		Signer:                   leafKey,
+		CertChain:                []*x509.Certificate{leafCert, subCert, rootCert},
+		DefaultPolicy:            authorized,
+		RequireCertificatePolicy: true,
+	}
+
+	i, err := New(opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); err != nil {
+		t.Fatalf("unexpected error issuing timestamp under an authorized policy: %v", err)
+	}
+	// any policy is accepted, but only authorized policies are issued
+	_, err = i.Issue(context.Background(), newTestRequest(asn1.ObjectIdentifier{1, 2, 4}))
+	var ierr *Error
+	if !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.UnacceptedPolicy {
+		t.Fatalf("expected unaccepted policy error, got %v", err)
+	}
+
+	opts.AcceptedPolicies = []asn1.ObjectIdentifier{{1, 2, 4}}
+	if _, err := New(opts); err == nil {
+		t.Fatal("expected error creating issuer accepting a policy the certificate does not list")
+	}
+}
+
+func TestIssueRejectsInvalidRequests(t *testing.T) {
+	i, _ := newTestIssuer(t, Options{})
+
+	weak := newTestRequest(nil)
+	weak.HashAlgorithm = crypto.SHA1
+	short := newTestRequest(nil)
+	short.HashedMessage = short.HashedMessage[:10]
+	// a client must not be able to choose the link of its timestamp
+	forgedLink, _ := linking.Link{Index: 999, Previous: linking.Genesis}.Extension()
+	linked := newTestRequest(nil)
+	linked.Extensions = []pkix.Extension{forgedLink}
+
+	for _, tc := range []struct {
+		req *timestamp.Request
+		fi  timestamp.FailureInfo
+	}{{weak, timestamp.BadAlgorithm}, {short, timestamp.BadDataFormat}, {linked, timestamp.BadRequest}} {
+		_, err := i.Issue(context.Background(), tc.req)
+		var ierr *Error
+		if !errors.As(err, &ierr) || ierr.FailureInfo != tc.fi {
+			t.Fatalf("expected %v error, got %v", tc.fi, err)
+		}
+	}
+}
+
+func TestIssueDER(t *testing.T) {
+	i, _ := newTestIssuer(t, Options{})
+
+	der, err := newTestRequest(nil).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := i.IssueDER(context.Background(), der)
+	if err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	if _, err := timestamp.ParseResponse(resp); err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+
+	_, err = i.IssueDER(context.Background(), []byte("not a request"))
+	var ierr *Error
+	if !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.BadDataFormat {
+		t.Fatalf("expected %v error, got %v", timestamp.BadDataFormat, err)
+	}
+}
+
+func TestIssueOutsideCertificateValidity(t *testing.T) {
+	clock := fixedClock(time.Now().Add(2 * time.Hour))
+	i, _ := newTestIssuer(t, Options{Clock: clock})
+
+	_, err := i.Issue(context.Background(), newTestRequest(nil))
+	var ierr *Error
+	if !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure || ierr.IsClientError() {
+		t.Fatalf("expected system failure, got %v", err)
+	}
+}
+
+// faultySigner corrupts the signatures of its signer while faults remain.
+type faultySigner struct {
+	crypto.Signer
+	faults int
+}
+
+func (s *faultySigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	sig, err := s.Signer.Sign(rand, digest, opts)
+	if err == nil && s.faults > 0 {
+		s.faults--
+		sig[len(sig)/2] ^= 0xff
+	}
+	return sig, err
+}
+
+func TestVerifyAfterSign(t *testing.T) {
+	var events []*Event
+	observer := ObserverFunc(func(_ context.Context, e *Event) {
+		events = append(events, e)
+	})
+
+	// without verification, the faulty response is returned
+	i, _ := newTestIssuer(t, Options{})
+	i.signer = &faultySigner{Signer: i.signer, faults: 1}
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+
+	i, _ = newTestIssuer(t, Options{VerifyAfterSign: true, Observers: []Observer{observer}})
+	i.signer = &faultySigner{Signer: i.signer, faults: 1}
+	var ierr *Error
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure {
+		t.Fatalf("expected system failure for faulty signature, got %v", err)
+	}
+	if !events[0].VerifyFailed {
+		t.Fatal("expected event to record the verification failure")
+	}
+	// the issuer keeps signing once the signer recovers
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	if events[1].VerifyFailed || i.Ready() != nil {
+		t.Fatal("expected verified response and ready issuer")
+	}
+
+	i, _ = newTestIssuer(t, Options{VerifyAfterSign: true, TripOnVerifyFailure: true})
+	i.signer = &faultySigner{Signer: i.signer, faults: 1}
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); err == nil {
+		t.Fatal("expected error for faulty signature")
+	}
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure {
+		t.Fatalf("expected system failure once tripped, got %v", err)
+	}
+	if err := i.Ready(); err == nil {
+		t.Fatal("expected readiness error once tripped")
+	}
+	// requests are still checked before the tripped signer
+	weak := newTestRequest(nil)
+	weak.HashAlgorithm = crypto.SHA1
+	if _, err := i.Issue(context.Background(), weak); !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.BadAlgorithm {
+		t.Fatalf("expected bad algorithm error, got %v", err)
+	}
+}
+
+// recordingLinker links timestamps with a fixed extension, recording the
+// tokens it is given, and fails with err when set.
+type recordingLinker struct {
+	tokens [][]byte
+	err    error
+}
+
+var testLinkOID = asn1.ObjectIdentifier{1, 2, 3, 4}
+
+func (l *recordingLinker) Link(issue func(ext pkix.Extension) ([]byte, error)) error {
+	token, err := issue(pkix.Extension{Id: testLinkOID, Value: []byte{0x05, 0x00}})
+	if err != nil {
+		return err
+	}
+	if l.err != nil {
+		return l.err
+	}
+	l.tokens = append(l.tokens, token)
+	return nil
+}
+
+func TestIssueLinked(t *testing.T) {
+	linker := &recordingLinker{}
+	i, _ := newTestIssuer(t, Options{Linker: linker})
+	resp, err := i.Issue(context.Background(), newTestRequest(nil))
+	if err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	ts, err := timestamp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+	if len(linker.tokens) != 1 || !bytes.Equal(linker.tokens[0], ts.RawToken) {
+		t.Fatal("expected the token of the response to be linked")
+	}
+	if len(ts.Extensions) != 1 || !ts.Extensions[0].Id.Equal(testLinkOID) {
+		t.Fatalf("expected the link extension, got %v", ts.Extensions)
+	}
+
+	// a timestamp that cannot be linked is not returned
+	linker.err = errors.New("disk full")
+	var ierr *Error
+	if _, err := i.Issue(context.Background(), newTestRequest(nil)); !errors.As(err, &ierr) || ierr.FailureInfo != timestamp.SystemFailure {
+		t.Fatalf("expected system failure, got %v", err)
+	}
+}
+
+func TestReady(t *testing.T) {
+	i, _ := newTestIssuer(t, Options{ExpiryWarningWindow: time.Minute, FailReadinessOnExpiryWarning: true})
+	if err := i.Ready(); err != nil {
+		t.Fatalf("unexpected readiness error: %v", err)
+	}
+
+	// the test leaf certificate expires within two hours
+	i, _ = newTestIssuer(t, Options{ExpiryWarningWindow: 2 * time.Hour})
+	if err := i.CheckCertificateExpiry(); err == nil {
+		t.Fatal("expected certificate expiry warning")
+	}
+	if err := i.Ready(); err != nil {
+		t.Fatalf("unexpected readiness error: %v", err)
+	}
+
+	i, _ = newTestIssuer(t, Options{ExpiryWarningWindow: 2 * time.Hour, FailReadinessOnExpiryWarning: true})
+	if err := i.Ready(); err == nil {
+		t.Fatal("expected readiness error")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	i, _ := newTestIssuer(t, Options{})
+	server := httptest.NewServer(NewHandler(i))
+	t.Cleanup(server.Close)
+
+	post := func(req *timestamp.Request) *timestamp.Timestamp {
+		tsq, err := req.Marshal()
+		if err != nil {
+			t.Fatalf("unexpected error creating request: %v", err)
+		}
+		resp, err := http.Post(server.URL, timestampQueryMediaType, bytes.NewReader(tsq))
+		if err != nil {
+			t.Fatalf("unexpected error sending request: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != timestampReplyMediaType {
+			t.Fatalf("unexpected response: %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(resp.Body)
+		ts, err := timestamp.ParseResponse(body)
+		if err != nil {
+			return nil
+		}
+		return ts
+	}
+
+	if ts := post(newTestRequest(nil)); ts == nil {
+		t.Fatal("expected granted timestamp response")
+	}
+
+	weak := newTestRequest(nil)
+	weak.HashAlgorithm = crypto.SHA1
+	weak.HashedMessage = weak.HashedMessage[:20]
+	if ts := post(weak); ts != nil {
+		t.Fatal("expected rejected timestamp response")
+	}
+
+	resp, err := http.Post(server.URL, "application/json", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected unsupported media type, got %d", resp.StatusCode)
+	}
+}
+
+func TestObservers(t *testing.T) {
+	var events []*Event
+	observer := ObserverFunc(func(_ context.Context, e *Event) {
+		events = append(events, e)
+	})
+	i, _ := newTestIssuer(t, Options{AcceptedPolicies: []asn1.ObjectIdentifier{{1, 2, 3}}, Observers: []Observer{observer}})
+	ctx := WithRequestID(WithClient(context.Background(), "client"), "request")
+
+	if _, err := i.Issue(ctx, newTestRequest(nil)); err != nil {
+		t.Fatalf("unexpected error issuing timestamp: %v", err)
+	}
+	if _, err := i.Issue(ctx, newTestRequest(asn1.ObjectIdentifier{4, 5, 6})); err == nil {
+		t.Fatal("expected unaccepted policy error")
+	}
+	i.Reject(ctx, timestamp.BadDataFormat, "Invalid timestamp request", errors.New("malformed"))
+
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	granted := events[0]
+	if !granted.Granted() || granted.SerialNumber == nil || granted.GenTime.IsZero() || !granted.Policy.Equal(DefaultPolicy) || granted.Client != "client" || granted.RequestID != "request" {
+		t.Fatalf("unexpected granted event %+v", granted)
+	}
+	var ierr *Error
+	if rejected := events[1]; rejected.Granted() || rejected.SerialNumber != nil || !errors.As(rejected.Err, &ierr) || ierr.FailureInfo != timestamp.UnacceptedPolicy {
+		t.Fatalf("unexpected rejected event %+v", rejected)
+	}
+	if unparsed := events[2]; unparsed.Granted() || unparsed.Request != nil || !errors.As(unparsed.Err, &ierr) || ierr.FailureInfo != timestamp.BadDataFormat {
+		t.Fatalf("unexpected unparsed event %+v", unparsed)
+	}
+}
+
+func TestIssueAuthenticode(t *testing.T) {
+	now := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+	var events []*Event
+	observer := ObserverFunc(func(_ context.Context, e *Event) {
+		events = append(events, e)
+	})
+	i, chain := newTestIssuer(t, Options{Clock: fixedClock(now), Observers: []Observer{observer}})
+
+	content := []byte("signature of the signed file")
+	octets, _ := asn1.Marshal(content)
+	der, err := asn1.Marshal(struct {
+		Type    asn1.ObjectIdentifier
+		Content contentInfo
+	}{oidAuthenticodeTimestampRequest, contentInfo{ContentType: pkcs7.OIDData, Content: explicitContent(octets)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := ParseAuthenticodeRequest(der)
+	if err != nil {
+		t.Fatalf("unexpected error parsing request: %v", err)
+	}
+	if !bytes.Equal(req.Content, content) {
+		t.Fatalf("expected content %q, got %q", content, req.Content)
+	}
+
+	resp, err := i.IssueAuthenticode(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error issuing countersignature: %v", err)
+	}
+	p7, err := pkcs7.Parse(resp)
+	if err != nil {
+		t.Fatalf("unexpected error parsing countersignature: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(chain[2])
+	if err := p7.VerifyWithChainAtTime(roots, now); err != nil {
+		t.Fatalf("unexpected error verifying countersignature: %v", err)
+	}
+	var signingTime time.Time
+	if err := p7.UnmarshalSignedAttribute(pkcs7.OIDAttributeSigningTime, &signingTime); err != nil || !signingTime.Equal(now) {
+		t.Fatalf("expected signing time %v from clock, got %v (%v)", now, signingTime, err)
+	}
+	digest := sha256.Sum256(content)
+	if len(events) != 1 || !events[0].Granted() || !bytes.Equal(events[0].Request.HashedMessage, digest[:]) {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	tsq, _ := newTestRequest(nil).Marshal()
+	if _, err := ParseAuthenticodeRequest(tsq); err == nil {
+		t.Fatal("expected error parsing an RFC 3161 request")
+	}
+}
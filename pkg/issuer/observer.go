@@ -0,0 +1,171 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issuer
+
+import (
+	"context"
+	"encoding/asn1"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/go-chi/chi/middleware"
+)
+
+// Event describes the outcome of a timestamp request.
+type Event struct {
+	// Request is the parsed request, or nil if the request could not be parsed.
+	Request *timestamp.Request
+	// Policy of the timestamp. Set once the requested policy is accepted.
+	Policy asn1.ObjectIdentifier
+	// GenTime is the generation time of the timestamp. Set once the policy is accepted.
+	GenTime time.Time
+	// LocalTime is the time of the clock of the issuer from which GenTime was
+	// computed, which differs from GenTime when corrected, see Options.ClockOffset.
+	LocalTime time.Time
+	// SerialNumber of the issued timestamp. Set when the request is granted.
+	SerialNumber *big.Int
+	// SignerBackend names the kind of signer, see Options.SignerBackend.
+	SignerBackend string
+	// SignDuration is the time spent signing the response. Set once signed.
+	SignDuration time.Duration
+	// VerifyFailed reports whether the signed response failed verification,
+	// see Options.VerifyAfterSign.
+	VerifyFailed bool
+	// Client identifies the client making the request, see WithClient.
+	Client string
+	// RequestID identifies the request, if it has an ID, see WithRequestID.
+	RequestID string
+	// Err is nil when the request is granted, and otherwise an *Error.
+	Err error
+}
+
+// Granted reports whether a timestamp was issued.
+func (e *Event) Granted() bool {
+	return e.Err == nil
+}
+
+// HashAlgorithm returns the lower-case name of the hash algorithm of the
+// request, such as sha256, or an empty string if the request could not be parsed.
+func (e *Event) HashAlgorithm() string {
+	if e.Request == nil {
+		return ""
+	}
+	return strings.ToLower(strings.ReplaceAll(e.Request.HashAlgorithm.String(), "-", ""))
+}
+
+// Observer is notified of the outcome of every timestamp request, after the
+// response has been created and before it is returned.
+type Observer interface {
+	ObserveIssuance(ctx context.Context, e *Event)
+}
+
+// Recorder is an Observer whose record of a granted timestamp must be written
+// before the timestamp is returned, such as an audit log. When RecordIssuance
+// fails for a granted timestamp, the response is withheld and the request
+// fails with systemFailure. Recorders are called before the other observers,
+// which see the outcome returned to the client.
+type Recorder interface {
+	Observer
+	RecordIssuance(ctx context.Context, e *Event) error
+}
+
+// ObserverFunc adapts a function to an Observer.
+type ObserverFunc func(ctx context.Context, e *Event)
+
+// ObserveIssuance calls f(ctx, e).
+func (f ObserverFunc) ObserveIssuance(ctx context.Context, e *Event) {
+	f(ctx, e)
+}
+
+type clientKey struct{}
+
+// WithClient returns a context identifying the client of the requests made
+// with it.
+func WithClient(ctx context.Context, client string) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// ClientFrom returns the client identity set with WithClient.
+func ClientFrom(ctx context.Context) string {
+	client, _ := ctx.Value(clientKey{}).(string)
+	return client
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context identifying the requests made with it by
+// id, for requests that do not go through the HTTP request ID middleware.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID set with WithRequestID, or else the
+// one set by the HTTP request ID middleware.
+func RequestIDFrom(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	return middleware.GetReqID(ctx)
+}
+
+// RequestContext returns the context of an HTTP request, identifying the
+// client by the subject of its verified TLS certificate, or by its address.
+func RequestContext(r *http.Request) context.Context {
+	client := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		client = host
+	}
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		client = r.TLS.VerifiedChains[0][0].Subject.String()
+	}
+	return WithClient(r.Context(), client)
+}
+
+// notify fills in the request details of the event from the context and
+// passes it to every observer, returning the outcome of the request: e.Err,
+// or the error of a Recorder that failed to record a granted timestamp.
+func (i *Issuer) notify(ctx context.Context, e *Event) error {
+	if len(i.observers) == 0 {
+		return e.Err
+	}
+	e.Client = ClientFrom(ctx)
+	e.RequestID = RequestIDFrom(ctx)
+	for _, o := range i.observers {
+		if r, ok := o.(Recorder); ok {
+			if err := r.RecordIssuance(ctx, e); err != nil && e.Granted() {
+				e.Err = newError(timestamp.SystemFailure, "Error recording timestamp response", err)
+			}
+		}
+	}
+	for _, o := range i.observers {
+		if _, ok := o.(Recorder); !ok {
+			o.ObserveIssuance(ctx, e)
+		}
+	}
+	return e.Err
+}
+
+// Reject records a request that was rejected before it could be passed to
+// Issue, for instance because it could not be parsed, and returns the error.
+func (i *Issuer) Reject(ctx context.Context, fi timestamp.FailureInfo, message string, err error) *Error {
+	ierr := newError(fi, message, err)
+	_ = i.notify(ctx, &Event{SignerBackend: i.signerBackend, Err: ierr})
+	return ierr
+}
@@ -0,0 +1,350 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package linking links issued timestamps into a hash chain, in the style of
+// the linked timestamps of ISO/IEC 18014-3, so that once a head of the chain
+// is published, no timestamp can be added before it.
+//
+// Each timestamp carries an extension holding its index in the chain and the
+// head of the chain before it. Once the timestamp is issued, the head becomes
+// the SHA-256 hash of the previous head followed by the SHA-256 hash of the
+// DER-encoded TimeStampToken. The chain is stored in an append-only file of
+// JSON entries, one per line, and is replayed and checked when opened.
+package linking
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	// OID identifies the extension of linked timestamps.
+	OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2, 1}
+	// Genesis is the head of the empty chain.
+	Genesis = make([]byte, sha256.Size)
+
+	// ErrNotLinked is returned for a timestamp without the linking extension.
+	ErrNotLinked = errors.New("timestamp is not linked")
+)
+
+// Link is the value of the linking extension:
+//
+//	Link ::= SEQUENCE {
+//	  index     INTEGER,
+//	  previous  OCTET STRING }
+type Link struct {
+	// Index is the position of the timestamp in the chain, starting at 0.
+	Index int64
+	// Previous is the head of the chain before the timestamp.
+	Previous []byte
+}
+
+// Extension encodes the link as a non-critical extension.
+func (l Link) Extension() (pkix.Extension, error) {
+	value, err := asn1.Marshal(l)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: OID, Value: value}, nil
+}
+
+// FromExtensions returns the link in the extensions of a timestamp, or
+// ErrNotLinked if there is none. A timestamp carrying more than one link is
+// rejected, as only one of them can have been set by the issuer.
+func FromExtensions(exts []pkix.Extension) (*Link, error) {
+	var found *pkix.Extension
+	for i := range exts {
+		if !exts[i].Id.Equal(OID) {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New("timestamp carries more than one link")
+		}
+		found = &exts[i]
+	}
+	if found == nil {
+		return nil, ErrNotLinked
+	}
+	var l Link
+	rest, err := asn1.Unmarshal(found.Value, &l)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link: %w", err)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("parsing link: trailing data")
+	}
+	if l.Index < 0 || len(l.Previous) != sha256.Size {
+		return nil, fmt.Errorf("invalid link at index %d", l.Index)
+	}
+	return &l, nil
+}
+
+// TokenHash returns the SHA-256 hash of a DER-encoded TimeStampToken.
+func TokenHash(token []byte) []byte {
+	sum := sha256.Sum256(token)
+	return sum[:]
+}
+
+// NextHead returns the head of the chain after appending a timestamp.
+func NextHead(head, tokenHash []byte) []byte {
+	h := sha256.New()
+	h.Write(head)
+	h.Write(tokenHash)
+	return h.Sum(nil)
+}
+
+// Entry is a timestamp in the chain, as published.
+type Entry struct {
+	Index int64 `json:"index"`
+	// TokenHash is the hex-encoded SHA-256 hash of the TimeStampToken.
+	TokenHash string `json:"tokenHash"`
+	// Head is the hex-encoded head of the chain after the timestamp.
+	Head string `json:"head"`
+}
+
+// indexInterval is the number of entries between two offsets kept in memory.
+// Entries are read from the file, starting at the closest offset before them.
+const indexInterval = 1024
+
+// Chain is the chain of linked timestamps. Only its length, head and a sparse
+// index of entry offsets are kept in memory; entries are read from the file.
+type Chain struct {
+	// linkMu serializes issuance, so that each timestamp links to the one
+	// issued before it.
+	linkMu sync.Mutex
+
+	mu   sync.RWMutex
+	path string
+	f    *os.File
+	// r reads entries, up to size.
+	r      *os.File
+	length int64
+	head   []byte
+	// offsets holds the offset in the file of every indexInterval-th entry.
+	offsets []int64
+	// size is the length of the file up to the last entry synced to disk.
+	size int64
+	// err is set once an entry could not be recorded, after which no
+	// timestamp is linked.
+	err error
+}
+
+// Open opens the chain at path for appending, creating it if needed. It fails
+// if an entry does not follow from the ones before it.
+func Open(path string) (*Chain, error) {
+	c := &Chain{path: path, head: Genesis}
+	if err := c.replay(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading link chain %s: %w", path, err)
+	}
+	f, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	r, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		r.Close()
+		return nil, err
+	}
+	c.f, c.r, c.size = f, r, info.Size()
+	return c, nil
+}
+
+// replay reads the entries of the chain, checking each head, and indexes
+// their offsets.
+func (c *Chain) replay() error {
+	f, err := os.Open(filepath.Clean(c.path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var offset int64
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				return fmt.Errorf("entry %d is incomplete, the chain was truncated", c.length)
+			}
+			e, err := parseEntry(line, c.length)
+			if err != nil {
+				return err
+			}
+			tokenHash, herr := hex.DecodeString(e.TokenHash)
+			if herr != nil || len(tokenHash) != sha256.Size {
+				return fmt.Errorf("entry %d is invalid", c.length)
+			}
+			head := NextHead(c.head, tokenHash)
+			if hex.EncodeToString(head) != e.Head {
+				return fmt.Errorf("head of entry %d does not follow from the previous entries", e.Index)
+			}
+			if c.length%indexInterval == 0 {
+				c.offsets = append(c.offsets, offset)
+			}
+			c.head = head
+			c.length++
+			offset += int64(len(line))
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// parseEntry parses the line of the entry at index.
+func parseEntry(line []byte, index int64) (Entry, error) {
+	var e Entry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return Entry{}, fmt.Errorf("parsing entry %d: %w", index, err)
+	}
+	if e.Index != index {
+		return Entry{}, fmt.Errorf("entry %d is invalid", index)
+	}
+	return e, nil
+}
+
+// Link calls issue with the extension linking the next timestamp to the chain,
+// and appends the DER-encoded TimeStampToken it returns. The entry is synced
+// to disk before Link returns, so that no timestamp is handed out unrecorded.
+// Calls are serialized.
+func (c *Chain) Link(issue func(ext pkix.Extension) (token []byte, err error)) error {
+	c.linkMu.Lock()
+	defer c.linkMu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+
+	c.mu.RLock()
+	index, head := c.length, c.head
+	c.mu.RUnlock()
+
+	ext, err := Link{Index: index, Previous: head}.Extension()
+	if err != nil {
+		return err
+	}
+	token, err := issue(ext)
+	if err != nil {
+		return err
+	}
+
+	tokenHash := TokenHash(token)
+	next := NextHead(head, tokenHash)
+	line, err := json.Marshal(Entry{Index: index, TokenHash: hex.EncodeToString(tokenHash), Head: hex.EncodeToString(next)})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if _, err := c.f.Write(line); err != nil {
+		return c.fail(fmt.Errorf("writing link chain %s: %w", c.path, err))
+	}
+	if err := c.f.Sync(); err != nil {
+		return c.fail(fmt.Errorf("syncing link chain %s: %w", c.path, err))
+	}
+
+	c.mu.Lock()
+	if index%indexInterval == 0 {
+		c.offsets = append(c.offsets, c.size)
+	}
+	c.head = next
+	c.length++
+	c.size += int64(len(line))
+	c.mu.Unlock()
+	return nil
+}
+
+// fail stops linking after an entry could not be recorded, as whether it
+// reached the disk is unknown. The file is truncated back to the last synced
+// entry, so that the chain can be reopened once the cause is fixed.
+// c.linkMu must be held.
+func (c *Chain) fail(err error) error {
+	c.err = fmt.Errorf("link chain is unusable until reopened: %w", err)
+	if terr := os.Truncate(filepath.Clean(c.path), c.size); terr != nil {
+		return fmt.Errorf("%w; truncating to the last entry: %v", c.err, terr)
+	}
+	return c.err
+}
+
+// Len returns the number of timestamps in the chain.
+func (c *Chain) Len() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.length
+}
+
+// Head returns the number of timestamps in the chain and its current head.
+func (c *Chain) Head() (int64, []byte) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.length, bytes.Clone(c.head)
+}
+
+// Entries reads the entries from start up to, but excluding, end, bounded by
+// the length of the chain.
+func (c *Chain) Entries(start, end int64) ([]Entry, error) {
+	c.mu.RLock()
+	end = min(end, c.length)
+	if start < 0 || start >= end {
+		c.mu.RUnlock()
+		return []Entry{}, nil
+	}
+	offset, size := c.offsets[start/indexInterval], c.size
+	c.mu.RUnlock()
+
+	// the file is only appended to, so entries up to size do not change
+	reader := bufio.NewReader(io.NewSectionReader(c.r, offset, size-offset))
+	entries := make([]Entry, 0, end-start)
+	for i := start - start%indexInterval; i < end; i++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return nil, fmt.Errorf("reading entry %d of link chain %s: %w", i, c.path, err)
+		}
+		if i < start {
+			continue
+		}
+		e, err := parseEntry(line, i)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// Close closes the chain.
+func (c *Chain) Close() error {
+	c.linkMu.Lock()
+	defer c.linkMu.Unlock()
+	return errors.Join(c.f.Close(), c.r.Close())
+}
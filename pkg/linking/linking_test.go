@@ -0,0 +1,258 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linking
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// link appends a token to the chain, returning the link it was issued with.
+func link(t *testing.T, c *Chain, token []byte) *Link {
+	t.Helper()
+	var l *Link
+	err := c.Link(func(ext pkix.Extension) ([]byte, error) {
+		var err error
+		l, err = FromExtensions([]pkix.Extension{ext})
+		return token, err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error linking: %v", err)
+	}
+	return l
+}
+
+func TestChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "links.jsonl")
+	c, err := Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error opening chain: %v", err)
+	}
+
+	head := Genesis
+	for i := 0; i < 3; i++ {
+		token := []byte(fmt.Sprintf("token %d", i))
+		l := link(t, c, token)
+		if l.Index != int64(i) || !bytes.Equal(l.Previous, head) {
+			t.Fatalf("expected link %d to %x, got link %d to %x", i, head, l.Index, l.Previous)
+		}
+		head = NextHead(head, TokenHash(token))
+	}
+
+	// a failed issuance is not linked
+	if err := c.Link(func(pkix.Extension) ([]byte, error) { return nil, errors.New("failed") }); err == nil {
+		t.Fatal("expected error")
+	}
+	if length, h := c.Head(); length != 3 || !bytes.Equal(h, head) {
+		t.Fatalf("expected head %x at length 3, got %x at length %d", head, h, length)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the chain is replayed when reopened
+	c, err = Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error reopening chain: %v", err)
+	}
+	defer c.Close()
+	if l := link(t, c, []byte("token 3")); l.Index != 3 || !bytes.Equal(l.Previous, head) {
+		t.Fatalf("expected link 3 to %x, got link %d to %x", head, l.Index, l.Previous)
+	}
+
+	entries, err := c.Entries(1, 10)
+	if err != nil {
+		t.Fatalf("unexpected error reading entries: %v", err)
+	}
+	if len(entries) != 3 || entries[0].Index != 1 || entries[2].Index != 3 {
+		t.Fatalf("expected entries 1 to 3, got %v", entries)
+	}
+	if entries[1].Head != hex.EncodeToString(head) {
+		t.Fatalf("expected head of entry 2 to be %x, got %s", head, entries[1].Head)
+	}
+	for _, r := range [][2]int64{{4, 10}, {2, 1}, {-1, 2}} {
+		if entries, err := c.Entries(r[0], r[1]); err != nil || len(entries) != 0 {
+			t.Fatalf("expected no entries from %d to %d, got %v, err: %v", r[0], r[1], entries, err)
+		}
+	}
+}
+
+func TestEntriesAcrossIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "links.jsonl")
+	c, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := int64(2*indexInterval + 10)
+	heads := make([]string, 0, n)
+	head := Genesis
+	for i := int64(0); i < n; i++ {
+		token := []byte(fmt.Sprintf("token %d", i))
+		link(t, c, token)
+		head = NextHead(head, TokenHash(token))
+		heads = append(heads, hex.EncodeToString(head))
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// entries are read from the offsets indexed when linking and when reopening
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if len(c.offsets) != 3 || !slices.Equal(c.offsets, reopened.offsets) {
+		t.Fatalf("expected 3 matching offsets, got %v and %v", c.offsets, reopened.offsets)
+	}
+	for _, r := range [][2]int64{{0, 5}, {indexInterval - 2, indexInterval + 2}, {2*indexInterval + 3, n}, {n - 1, n + 5}} {
+		entries, err := reopened.Entries(r[0], r[1])
+		if err != nil {
+			t.Fatalf("unexpected error reading entries %d to %d: %v", r[0], r[1], err)
+		}
+		if int64(len(entries)) != min(r[1], n)-r[0] {
+			t.Fatalf("expected entries %d to %d, got %d entries", r[0], r[1], len(entries))
+		}
+		for i, e := range entries {
+			if index := r[0] + int64(i); e.Index != index || e.Head != heads[index] {
+				t.Fatalf("expected entry %d with head %s, got %+v", index, heads[index], e)
+			}
+		}
+	}
+}
+
+func TestLinkWriteFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "links.jsonl")
+	c, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	link(t, c, []byte("token 0"))
+	_, head := c.Head()
+
+	// a chain whose file can no longer be written refuses to link, and drops
+	// whatever part of the entry reached the disk
+	f := c.f
+	if c.f, err = os.Open(path); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(`{"index":1,`); err != nil {
+		t.Fatal(err)
+	}
+	issued := 0
+	issue := func(pkix.Extension) ([]byte, error) {
+		issued++
+		return []byte("token 1"), nil
+	}
+	if err := c.Link(issue); err == nil {
+		t.Fatal("expected error writing the chain")
+	}
+	if err := c.Link(issue); err == nil || issued != 1 {
+		t.Fatalf("expected the chain to refuse further links, issued %d timestamps, err: %v", issued, err)
+	}
+	if length, h := c.Head(); length != 1 || !bytes.Equal(h, head) {
+		t.Fatalf("expected head %x at length 1, got %x at length %d", head, h, length)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the chain can be reopened and continued from the last recorded entry
+	c, err = Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error reopening chain: %v", err)
+	}
+	defer c.Close()
+	if l := link(t, c, []byte("token 1")); l.Index != 1 || !bytes.Equal(l.Previous, head) {
+		t.Fatalf("expected link 1 to %x, got link %d to %x", head, l.Index, l.Previous)
+	}
+}
+
+func TestOpenInvalidChain(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "links.jsonl")
+	c, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	link(t, c, []byte("token 0"))
+	link(t, c, []byte("token 1"))
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"truncated", lines[0] + strings.TrimSuffix(lines[1], "\n"), "incomplete"},
+		{"removed entry", lines[1], "entry 0 is invalid"},
+		{"replaced token", strings.Replace(lines[0], hex.EncodeToString(TokenHash([]byte("token 0"))), hex.EncodeToString(TokenHash([]byte("other"))), 1) + lines[1], "does not follow"},
+		{"not json", "token\n", "parsing entry 0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name)
+			if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Open(path); err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestFromExtensions(t *testing.T) {
+	ext, err := Link{Index: 7, Previous: Genesis}.Extension()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := pkix.Extension{Id: []int{1, 2, 3}, Value: []byte{0x05, 0x00}}
+	l, err := FromExtensions([]pkix.Extension{other, ext})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.Index != 7 || !bytes.Equal(l.Previous, Genesis) {
+		t.Fatalf("unexpected link %+v", l)
+	}
+
+	if _, err := FromExtensions([]pkix.Extension{other}); !errors.Is(err, ErrNotLinked) {
+		t.Fatalf("expected ErrNotLinked, got %v", err)
+	}
+	short, _ := Link{Index: 1, Previous: []byte{1}}.Extension()
+	if _, err := FromExtensions([]pkix.Extension{short}); err == nil {
+		t.Fatal("expected error for a short previous head")
+	}
+	forged, _ := Link{Index: 999, Previous: Genesis}.Extension()
+	if _, err := FromExtensions([]pkix.Extension{forged, ext}); err == nil {
+		t.Fatal("expected error for more than one link")
+	}
+}
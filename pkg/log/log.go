@@ -28,6 +28,10 @@ import (
 // Logger set the default logger to development mode
 var Logger *zap.SugaredLogger
 
+// Level is the level of Logger, which can be changed at runtime. Its
+// ServeHTTP method reports the level on GET and changes it on PUT.
+var Level = zap.NewAtomicLevel()
+
 func init() {
 	ConfigureLogger("dev")
 }
@@ -42,6 +46,8 @@ func ConfigureLogger(logType string) {
 		cfg = zap.NewDevelopmentConfig()
 		cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	}
+	Level.SetLevel(cfg.Level.Level())
+	cfg.Level = Level
 	logger, err := cfg.Build()
 	if err != nil {
 		log.Fatalln("createLogger", err)
@@ -61,3 +61,24 @@ func LoadConfig(path string) (*Config, error) {
 
 	return &cfg, nil
 }
+
+// Validate performs sanity checks of the configuration.
+func (c *Config) Validate() error {
+	if len(c.Servers) == 0 || len(c.Servers) < c.NumServers {
+		return ErrTooFewServers
+	}
+
+	if c.ServerThreshold < 1 {
+		return ErrThreshold
+	}
+
+	if c.ServerThreshold > c.NumServers {
+		return ErrTooFewServers
+	}
+
+	if c.RequestTimeout < 1 || c.MaxTimeDelta < c.RequestTimeout {
+		return ErrDeltaTooSmall
+	}
+
+	return nil
+}
@@ -16,10 +16,12 @@
 package ntpmonitor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
-	"sync/atomic"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/beevik/ntp"
@@ -48,6 +50,40 @@ var (
 type serverResponses struct {
 	tooFewServerResponses   bool
 	tooManyInvalidResponses bool
+	// offsets of the local clock from the servers that responded
+	offsets []time.Duration
+	// offsets of the local clock from the servers that agreed with it
+	agreed []time.Duration
+}
+
+// synced reports whether enough servers responded and agreed with the local time.
+func (r serverResponses) synced() bool {
+	return !r.tooFewServerResponses && !r.tooManyInvalidResponses
+}
+
+// medianOffset returns the median of the offsets of the local clock from the
+// servers that responded.
+func (r serverResponses) medianOffset() time.Duration {
+	return median(r.offsets)
+}
+
+// consensusOffset returns the median of the offsets of the local clock from
+// the servers that agreed with it, leaving out servers too far off to trust.
+func (r serverResponses) consensusOffset() time.Duration {
+	return median(r.agreed)
+}
+
+func median(offsets []time.Duration) time.Duration {
+	if len(offsets) == 0 {
+		return 0
+	}
+	offsets = slices.Clone(offsets)
+	slices.Sort(offsets)
+	mid := len(offsets) / 2
+	if len(offsets)%2 == 1 {
+		return offsets[mid]
+	}
+	return (offsets[mid-1] + offsets[mid]) / 2
 }
 
 type NTPClient interface {
@@ -63,8 +99,13 @@ func (c LiveNTPClient) QueryWithOptions(srv string, opts ntp.QueryOptions) (*ntp
 // NTPMonitor compares the local time with a set of trusted NTP servers.
 type NTPMonitor struct {
 	cfg       *Config
-	run       atomic.Bool
 	ntpClient NTPClient
+
+	mu   sync.Mutex
+	stop context.CancelFunc // stops the monitor started by Start
+	// consensus offset of the last poll, valid while the local time is in sync
+	offset    time.Duration
+	hasOffset bool
 }
 
 // New creates a NTPMonitor, reading the configuration from the provided
@@ -85,34 +126,23 @@ func NewFromConfig(cfg *Config) (*NTPMonitor, error) {
 }
 
 func NewFromConfigWithClient(cfg *Config, client NTPClient) (*NTPMonitor, error) {
-	if len(cfg.Servers) == 0 || len(cfg.Servers) < cfg.NumServers {
-		return nil, ErrTooFewServers
-	}
-
-	if cfg.ServerThreshold < 1 {
-		return nil, ErrThreshold
-	}
-
-	if cfg.ServerThreshold > cfg.NumServers {
-		return nil, ErrTooFewServers
-	}
-
-	if cfg.RequestTimeout < 1 || cfg.MaxTimeDelta < cfg.RequestTimeout {
-		return nil, ErrDeltaTooSmall
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
 
 	return &NTPMonitor{cfg: cfg, ntpClient: client}, nil
 }
 
-func (n *NTPMonitor) queryServers(delta time.Duration, servers []string) serverResponses {
+func (n *NTPMonitor) queryServers(ctx context.Context, delta time.Duration, servers []string) serverResponses {
 	validResponses := 0
 	noResponse := 0
+	var offsets, agreed []time.Duration
 	for _, srv := range servers {
 		// Create a time interval from 'now' with the max
 		// time delta added/removed
 		// Make sure the time from the remote NTP server lies
 		// within this interval.
-		resp, err := n.queryNTPServer(srv)
+		resp, err := n.queryNTPServer(ctx, srv)
 		if err != nil {
 			log.Logger.Errorf("ntp response timeout from %s",
 				srv)
@@ -126,11 +156,16 @@ func (n *NTPMonitor) queryServers(delta time.Duration, servers []string) serverR
 		// sending and receiving data.
 		// The estimated offset does not depend on the value
 		// of the latency.
+		offsets = append(offsets, resp.ClockOffset)
+		pkgapi.MetricNTPOffset.With(map[string]string{
+			"host": srv,
+		}).Set(resp.ClockOffset.Seconds())
 		if resp.ClockOffset.Abs() > delta {
 			log.Logger.Warnf("local time is different from %s: %s",
 				srv, resp.Time)
 		} else {
 			validResponses++
+			agreed = append(agreed, resp.ClockOffset)
 		}
 	}
 
@@ -138,13 +173,23 @@ func (n *NTPMonitor) queryServers(delta time.Duration, servers []string) serverR
 	return serverResponses{
 		tooFewServerResponses:   n.cfg.ServerThreshold > n.cfg.NumServers-noResponse,
 		tooManyInvalidResponses: n.cfg.ServerThreshold > validResponses,
+		offsets:                 offsets,
+		agreed:                  agreed,
 	}
 }
 
-// Start the periodic monitor. Once started, it runs until Stop() is called,
+// Start the periodic monitor. Once started, it runs until Stop() is called.
 func (n *NTPMonitor) Start() {
-	n.run.Store(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	n.mu.Lock()
+	n.stop = cancel
+	n.mu.Unlock()
+	n.Run(ctx)
+}
 
+// Run the periodic monitor until ctx is done. Run returns as soon as ctx is
+// done, without waiting for the next poll.
+func (n *NTPMonitor) Run(ctx context.Context) {
 	if n.cfg.RequestTimeout < 1 {
 		log.Logger.Warnf("NTP request timeout not set, default to 1s")
 		n.cfg.RequestTimeout = 1
@@ -156,9 +201,19 @@ func (n *NTPMonitor) Start() {
 	//nolint:gosec
 	r := rand.New(rand.NewSource(time.Now().UTC().UnixNano())) // initialize local pseudorandom generator //nolint:gosec
 
-	for n.run.Load() {
+	for {
 		servers := RandomChoice(n.cfg.Servers, n.cfg.NumServers, r)
-		responses := n.queryServers(delta, servers)
+		responses := n.queryServers(ctx, delta, servers)
+		if ctx.Err() != nil {
+			break
+		}
+		if len(responses.offsets) > 0 {
+			pkgapi.MetricNTPConsensusOffset.Set(responses.medianOffset().Seconds())
+		}
+		if responses.synced() {
+			pkgapi.MetricNTPLastSync.SetLastSync(time.Now())
+		}
+		n.publish(responses)
 
 		// Did enough NTP servers respond?
 		if responses.tooFewServerResponses {
@@ -173,20 +228,66 @@ func (n *NTPMonitor) Start() {
 		}
 
 		// Local time is in sync. Wait for next poll.
-		time.Sleep(time.Duration(n.cfg.Period) * time.Second)
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(n.cfg.Period) * time.Second):
+			continue
+		}
+		break
 	}
+	n.publish(serverResponses{tooFewServerResponses: true})
 	log.Logger.Info("ntp monitoring stopped")
 }
 
-// Stop the monitoring.
+// publish makes the consensus offset of a poll available through Offset, or
+// withdraws it when the poll did not find the local time in sync.
+func (n *NTPMonitor) publish(r serverResponses) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.offset, n.hasOffset = r.consensusOffset(), r.synced()
+}
+
+// Offset returns the median offset of the local clock from the servers that
+// agreed with it in the last poll, to be added to the local time. No offset
+// is returned unless the last poll of a running monitor found the local time
+// in sync.
+func (n *NTPMonitor) Offset() (time.Duration, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.offset, n.hasOffset
+}
+
+// Check queries a random selection of the configured servers once, returning
+// ErrTooFewServers if too few of them responded or ErrInvTime if too few of
+// them agree with the local time.
+func (n *NTPMonitor) Check() error {
+	//nolint:gosec
+	r := rand.New(rand.NewSource(time.Now().UTC().UnixNano())) // initialize local pseudorandom generator //nolint:gosec
+	servers := RandomChoice(n.cfg.Servers, n.cfg.NumServers, r)
+	responses := n.queryServers(context.Background(), time.Duration(n.cfg.MaxTimeDelta)*time.Second, servers)
+	if responses.tooFewServerResponses {
+		return ErrTooFewServers
+	}
+	if responses.tooManyInvalidResponses {
+		return ErrInvTime
+	}
+	return nil
+}
+
+// Stop the monitoring started by Start.
 func (n *NTPMonitor) Stop() {
 	log.Logger.Info("stopping ntp monitoring")
-	n.run.Store(false)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stop != nil {
+		n.stop()
+	}
 }
 
 // queryNTPServer queries a provided ntp server, trying up to a configured
-// amount of times. There is one second sleep between each attempt.
-func (n *NTPMonitor) queryNTPServer(srv string) (*ntp.Response, error) {
+// amount of times. There is one second sleep between each attempt, cut short
+// if ctx is done.
+func (n *NTPMonitor) queryNTPServer(ctx context.Context, srv string) (*ntp.Response, error) {
 	var i = 1
 	for {
 		log.Logger.Debugf("querying ntp server %s", srv)
@@ -218,7 +319,11 @@ func (n *NTPMonitor) queryNTPServer(srv string) (*ntp.Response, error) {
 			break
 		}
 		i++
-		time.Sleep(time.Second)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
 	}
 	return nil, fmt.Errorf("ntp timeout: %s", srv)
 }
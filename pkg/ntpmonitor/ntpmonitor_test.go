@@ -16,6 +16,7 @@
 package ntpmonitor
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -191,7 +192,7 @@ func TestNTPMonitorQueryNTPServer(t *testing.T) {
 			t.Fatalf("unexpectedly failed to create NTP monitor: %v", err)
 		}
 
-		resp, err := monitor.queryNTPServer("s1")
+		resp, err := monitor.queryNTPServer(context.Background(), "s1")
 		if tc.expectTestToPass && err != nil {
 			t.Errorf("test '%s' unexpectedly failed with non-nil error: %v", tc.name, err)
 		}
@@ -296,7 +297,7 @@ func TestNTPMonitorQueryServers(t *testing.T) {
 		delta := time.Duration(tc.maxTimeDelta) * time.Second
 		testedServers := []string{"s1", "s2", "s3"}
 
-		responses := monitor.queryServers(delta, testedServers)
+		responses := monitor.queryServers(context.Background(), delta, testedServers)
 		if tc.expectEnoughServerResponse && responses.tooFewServerResponses {
 			t.Errorf("test '%s' unexpectedly failed with too few server responses", tc.name)
 		}
@@ -305,3 +306,120 @@ func TestNTPMonitorQueryServers(t *testing.T) {
 		}
 	}
 }
+
+func TestNTPMonitorCheck(t *testing.T) {
+	cfg := &Config{
+		Servers:         []string{"s1", "s2", "s3"},
+		NumServers:      3,
+		Period:          1,
+		RequestAttempts: 1,
+		RequestTimeout:  1,
+		ServerThreshold: 2,
+		MaxTimeDelta:    2,
+	}
+
+	testCases := []struct {
+		name        string
+		client      NTPClient
+		expectedErr error
+	}{
+		{"in sync", MockNTPClient{}, nil},
+		{"too few responses", MockNTPClient{ignoredServers: map[string]string{"s1": "", "s2": ""}}, ErrTooFewServers},
+		{"drifted time", driftedTimeNTPClient{driftedOffset: 5 * time.Second}, ErrInvTime},
+	}
+	for _, tc := range testCases {
+		monitor, err := NewFromConfigWithClient(cfg, tc.client)
+		if err != nil {
+			t.Fatalf("unexpectedly failed to create NTP monitor: %v", err)
+		}
+		if err := monitor.Check(); !errors.Is(err, tc.expectedErr) {
+			t.Errorf("test '%s': expected error %v, got %v", tc.name, tc.expectedErr, err)
+		}
+	}
+}
+
+type offsetNTPClient map[string]time.Duration
+
+func (c offsetNTPClient) QueryWithOptions(srv string, _ ntp.QueryOptions) (*ntp.Response, error) {
+	return &ntp.Response{
+		ClockOffset: c[srv],
+		Time:        time.Now(),
+	}, nil
+}
+
+func TestNTPMonitorOffsets(t *testing.T) {
+	client := offsetNTPClient{"s1": -3 * time.Second, "s2": 500 * time.Millisecond, "s3": time.Second}
+	monitor, err := NewFromConfigWithClient(&Config{
+		Servers:         []string{"s1", "s2", "s3"},
+		NumServers:      3,
+		Period:          1,
+		RequestAttempts: 1,
+		RequestTimeout:  1,
+		ServerThreshold: 2,
+		MaxTimeDelta:    2,
+	}, client)
+	if err != nil {
+		t.Fatalf("unexpectedly failed to create NTP monitor: %v", err)
+	}
+
+	responses := monitor.queryServers(context.Background(), 2*time.Second, []string{"s1", "s2", "s3"})
+	if !responses.synced() {
+		t.Fatal("expected local time to be in sync")
+	}
+	if offset := responses.medianOffset(); offset != 500*time.Millisecond {
+		t.Errorf("expected median offset of 500ms, got %v", offset)
+	}
+	if offset := testutil.ToFloat64(pkgapi.MetricNTPOffset.With(map[string]string{"host": "s1"})); offset != -3 {
+		t.Errorf("expected offset of -3s for s1, got %v", offset)
+	}
+
+	// s1 does not agree with the local time, and is left out of the consensus
+	if offset := responses.consensusOffset(); offset != 750*time.Millisecond {
+		t.Errorf("expected consensus offset of 750ms, got %v", offset)
+	}
+	if _, ok := monitor.Offset(); ok {
+		t.Error("expected no offset before the first poll")
+	}
+	monitor.publish(responses)
+	if offset, ok := monitor.Offset(); !ok || offset != 750*time.Millisecond {
+		t.Errorf("expected published offset of 750ms, got %v", offset)
+	}
+	responses.tooManyInvalidResponses = true
+	monitor.publish(responses)
+	if _, ok := monitor.Offset(); ok {
+		t.Error("expected offset to be withdrawn once out of sync")
+	}
+
+	responses.offsets = responses.offsets[1:]
+	if offset := responses.medianOffset(); offset != 750*time.Millisecond {
+		t.Errorf("expected median offset of 750ms, got %v", offset)
+	}
+}
+
+func TestNTPMonitorRunStopsWhenDone(t *testing.T) {
+	monitor, err := NewFromConfigWithClient(&Config{
+		Servers:         []string{"s1", "s2", "s3"},
+		NumServers:      3,
+		Period:          3600,
+		RequestAttempts: 1,
+		RequestTimeout:  1,
+		ServerThreshold: 2,
+		MaxTimeDelta:    2,
+	}, MockNTPClient{})
+	if err != nil {
+		t.Fatalf("unexpectedly failed to create NTP monitor: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		monitor.Run(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected monitor to stop without waiting for the next poll")
+	}
+}
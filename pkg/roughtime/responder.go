@@ -0,0 +1,174 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roughtime
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnsupportedVersion is returned for a request that does not offer
+	// Version.
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	// ErrUnknownServer is returned for a request addressed to another server.
+	ErrUnknownServer = errors.New("request is addressed to another server")
+)
+
+// certificate is a delegated key certified by the long-term key.
+type certificate struct {
+	key        ed25519.PrivateKey
+	minT, maxT time.Time
+	raw        []byte
+}
+
+// Responder answers requests with a delegated key, certified by a long-term
+// key for a validity window and replaced once half of the window has passed.
+type Responder struct {
+	signer    crypto.Signer
+	publicKey ed25519.PublicKey
+	serverID  []byte
+	validity  time.Duration
+
+	mu   sync.Mutex
+	cert *certificate
+}
+
+// NewResponder creates a responder whose delegated keys are certified by
+// signer, an Ed25519 key, for validity.
+func NewResponder(signer crypto.Signer, validity time.Duration) (*Responder, error) {
+	publicKey, ok := signer.Public().(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("roughtime requires an Ed25519 key, got %T", signer.Public())
+	}
+	if validity <= 0 {
+		return nil, errors.New("delegation validity must be positive")
+	}
+	return &Responder{signer: signer, publicKey: publicKey, serverID: ServerID(publicKey), validity: validity}, nil
+}
+
+// PublicKey returns the long-term public key of the responder.
+func (r *Responder) PublicKey() ed25519.PublicKey {
+	return r.publicKey
+}
+
+// certificate returns a delegation valid at now, certifying a new delegated
+// key when needed.
+func (r *Responder) certificate(now time.Time) (*certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c := r.cert; c != nil && !now.Before(c.minT) && !now.Add(r.validity/2).After(c.maxT) {
+		return c, nil
+	}
+
+	publicKey, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	// a minute of slack covers a clock correction moving the time backwards
+	c := &certificate{key: key, minT: now.Add(-time.Minute).Truncate(time.Second), maxT: now.Add(r.validity).Truncate(time.Second)}
+	dele, err := message{
+		TagMINT: uint64Value(uint64(c.minT.Unix())),
+		TagMAXT: uint64Value(uint64(c.maxT.Unix())),
+		TagPUBK: publicKey,
+	}.encode()
+	if err != nil {
+		return nil, err
+	}
+	signed := append(slices.Clone(delegationContext), dele...)
+	sig, err := r.signer.Sign(rand.Reader, signed, crypto.Hash(0))
+	if err != nil {
+		return nil, fmt.Errorf("certifying delegated key: %w", err)
+	}
+	// a signer hashing its input before signing would not produce an Ed25519 signature
+	if !ed25519.Verify(r.publicKey, signed, sig) {
+		return nil, errors.New("certifying delegated key: invalid signature from the long-term key")
+	}
+	if c.raw, err = (message{TagDELE: dele, TagSIG: sig}).encode(); err != nil {
+		return nil, err
+	}
+	r.cert = c
+	return c, nil
+}
+
+// Respond returns the signed response to a request packet, reporting the
+// midpoint and radius of the time of the server. The radius is rounded up to
+// whole seconds.
+func (r *Responder) Respond(request []byte, midpoint time.Time, radius time.Duration) ([]byte, error) {
+	if len(request) < MinRequestSize {
+		return nil, fmt.Errorf("request of %d bytes is shorter than %d bytes", len(request), MinRequestSize)
+	}
+	req, err := parsePacket(request)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := req.get(TagNONC, NonceSize)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := req.get(TagVER, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(decodeVersions(versions), Version) {
+		return nil, ErrUnsupportedVersion
+	}
+	if srv, ok := req[TagSRV]; ok && !bytes.Equal(srv, r.serverID) {
+		return nil, ErrUnknownServer
+	}
+
+	cert, err := r.certificate(midpoint)
+	if err != nil {
+		return nil, err
+	}
+	radiusSeconds := uint32(max(1, (radius+time.Second-1)/time.Second))
+	srep, err := message{
+		TagVER:  uint32Value(Version),
+		TagVERS: uint32Value(Version),
+		TagRADI: uint32Value(radiusSeconds),
+		TagMIDP: uint64Value(uint64(midpoint.Unix())),
+		// a tree of a single request, whose root is its leaf
+		TagROOT: leafHash(request),
+	}.encode()
+	if err != nil {
+		return nil, err
+	}
+	sig := ed25519.Sign(cert.key, append(slices.Clone(responseContext), srep...))
+	return packet(message{
+		TagSIG:  sig,
+		TagNONC: nonce,
+		TagPATH: nil,
+		TagSREP: srep,
+		TagCERT: cert.raw,
+		TagINDX: uint32Value(0),
+	})
+}
+
+func decodeVersions(b []byte) []uint32 {
+	versions := make([]uint32, 0, len(b)/4)
+	for i := 0; i+4 <= len(b); i += 4 {
+		versions = append(versions, binary.LittleEndian.Uint32(b[i:]))
+	}
+	return versions
+}
@@ -0,0 +1,280 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package roughtime implements the Roughtime protocol of the IETF draft
+// draft-ietf-ntp-roughtime-11, which provides signed, coarse proofs of the
+// current time for clock bootstrap.
+//
+// A server holds a long-term Ed25519 key, which certifies a delegated
+// Ed25519 key for a validity window. Each response carries the delegation
+// and the midpoint and radius of the server's time, signed with the
+// delegated key over the Merkle root of the requests it answers, which
+// binds the response to the nonce of the request.
+package roughtime
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Version is the version of the protocol, draft 11 of the IETF draft.
+const Version uint32 = 0x8000000b
+
+const (
+	// NonceSize is the size of the nonce of a request.
+	NonceSize = 32
+	// MinRequestSize is the minimum size of a request packet, which bounds
+	// the amplification of a response.
+	MinRequestSize = 1024
+
+	hashSize = 32
+)
+
+var (
+	packetMagic = []byte("ROUGHTIM")
+
+	delegationContext = []byte("RoughTime v1 delegation signature\x00")
+	responseContext   = []byte("RoughTime v1 response signature\x00")
+)
+
+// Tag identifies a value of a message.
+type Tag uint32
+
+func makeTag(s string) Tag {
+	return Tag(binary.LittleEndian.Uint32([]byte(s)))
+}
+
+// Tags of the protocol.
+var (
+	TagSIG  = makeTag("SIG\x00")
+	TagVER  = makeTag("VER\x00")
+	TagSRV  = makeTag("SRV\x00")
+	TagNONC = makeTag("NONC")
+	TagDELE = makeTag("DELE")
+	TagPATH = makeTag("PATH")
+	TagRADI = makeTag("RADI")
+	TagPUBK = makeTag("PUBK")
+	TagMIDP = makeTag("MIDP")
+	TagSREP = makeTag("SREP")
+	TagVERS = makeTag("VERS")
+	TagMINT = makeTag("MINT")
+	TagROOT = makeTag("ROOT")
+	TagCERT = makeTag("CERT")
+	TagMAXT = makeTag("MAXT")
+	TagINDX = makeTag("INDX")
+	TagZZZZ = makeTag("ZZZZ")
+)
+
+func (t Tag) String() string {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(t))
+	return string(bytes.TrimRight(b[:], "\x00"))
+}
+
+// message maps the tags of a message to their values.
+type message map[Tag][]byte
+
+// encode encodes the message: the number of tags, the offset of every value
+// but the first, the tags in ascending order and the values.
+func (m message) encode() ([]byte, error) {
+	tags := make([]Tag, 0, len(m))
+	for t := range m {
+		tags = append(tags, t)
+	}
+	slices.Sort(tags)
+
+	n := len(tags)
+	out := binary.LittleEndian.AppendUint32(nil, uint32(n))
+	offset := 0
+	for i, t := range tags {
+		if len(m[t])%4 != 0 {
+			return nil, fmt.Errorf("value of %s is not a multiple of 4 bytes", t)
+		}
+		if i > 0 {
+			out = binary.LittleEndian.AppendUint32(out, uint32(offset))
+		}
+		offset += len(m[t])
+	}
+	for _, t := range tags {
+		out = binary.LittleEndian.AppendUint32(out, uint32(t))
+	}
+	for _, t := range tags {
+		out = append(out, m[t]...)
+	}
+	return out, nil
+}
+
+// decodeMessage decodes a message, checking that the tags are in ascending
+// order and that the values are within the message.
+func decodeMessage(b []byte) (message, error) {
+	if len(b) < 4 || len(b)%4 != 0 {
+		return nil, errors.New("message is not a multiple of 4 bytes")
+	}
+	n := int(binary.LittleEndian.Uint32(b))
+	if n == 0 {
+		return message{}, nil
+	}
+	// bounds n before computing the size of the header
+	if n > len(b)/8 {
+		return nil, fmt.Errorf("message of %d bytes cannot hold %d tags", len(b), n)
+	}
+	header := 8 * n
+	offsets := make([]int, n+1)
+	for i := 1; i < n; i++ {
+		offsets[i] = int(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	values := b[header:]
+	offsets[n] = len(values)
+
+	m := make(message, n)
+	var prev Tag
+	for i := 0; i < n; i++ {
+		t := Tag(binary.LittleEndian.Uint32(b[4*n+4*i:]))
+		if i > 0 && t <= prev {
+			return nil, errors.New("tags are not in ascending order")
+		}
+		prev = t
+		start, end := offsets[i], offsets[i+1]
+		if start%4 != 0 || start > end || end > len(values) {
+			return nil, fmt.Errorf("invalid offset of %s", t)
+		}
+		m[t] = values[start:end]
+	}
+	return m, nil
+}
+
+// get returns the value of a tag, checking its size when size is positive.
+func (m message) get(t Tag, size int) ([]byte, error) {
+	v, ok := m[t]
+	if !ok {
+		return nil, fmt.Errorf("missing %s", t)
+	}
+	if size > 0 && len(v) != size {
+		return nil, fmt.Errorf("%s is %d bytes, expected %d", t, len(v), size)
+	}
+	return v, nil
+}
+
+func (m message) uint32(t Tag) (uint32, error) {
+	v, err := m.get(t, 4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(v), nil
+}
+
+func (m message) uint64(t Tag) (uint64, error) {
+	v, err := m.get(t, 8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(v), nil
+}
+
+func (m message) message(t Tag) (message, error) {
+	v, err := m.get(t, 0)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := decodeMessage(v)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", t, err)
+	}
+	return sub, nil
+}
+
+// packet frames a message as a packet.
+func packet(m message) ([]byte, error) {
+	msg, err := m.encode()
+	if err != nil {
+		return nil, err
+	}
+	out := append(slices.Clone(packetMagic), binary.LittleEndian.AppendUint32(nil, uint32(len(msg)))...)
+	return append(out, msg...), nil
+}
+
+// parsePacket decodes the message of a packet.
+func parsePacket(b []byte) (message, error) {
+	if len(b) < len(packetMagic)+4 || !bytes.Equal(b[:len(packetMagic)], packetMagic) {
+		return nil, errors.New("not a roughtime packet")
+	}
+	n := binary.LittleEndian.Uint32(b[len(packetMagic):])
+	msg := b[len(packetMagic)+4:]
+	if uint64(n) != uint64(len(msg)) {
+		return nil, fmt.Errorf("packet holds %d bytes, expected %d", len(msg), n)
+	}
+	return decodeMessage(msg)
+}
+
+func uint32Value(v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(nil, v)
+}
+
+func uint64Value(v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, v)
+}
+
+// hash is SHA-512 truncated to 32 bytes, the hash of the Merkle tree.
+func hash(parts ...[]byte) []byte {
+	h := sha512.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)[:hashSize]
+}
+
+func leafHash(request []byte) []byte {
+	return hash([]byte{0}, request)
+}
+
+func nodeHash(left, right []byte) []byte {
+	return hash([]byte{1}, left, right)
+}
+
+// ServerID returns the value of the SRV tag identifying the server with a
+// long-term public key.
+func ServerID(publicKey ed25519.PublicKey) []byte {
+	return hash([]byte{0xff}, publicKey)
+}
+
+// CreateRequest returns a request packet with a random nonce read from rand,
+// padded to MinRequestSize. The request is only answered by the server with
+// publicKey when it is not nil.
+func CreateRequest(rand io.Reader, publicKey ed25519.PublicKey) (request, nonce []byte, err error) {
+	nonce = make([]byte, NonceSize)
+	if _, err := io.ReadFull(rand, nonce); err != nil {
+		return nil, nil, err
+	}
+	m := message{TagVER: uint32Value(Version), TagNONC: nonce, TagZZZZ: nil}
+	if publicKey != nil {
+		m[TagSRV] = ServerID(publicKey)
+	}
+	unpadded, err := packet(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	m[TagZZZZ] = make([]byte, MinRequestSize-len(unpadded))
+	request, err = packet(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	return request, nonce, nil
+}
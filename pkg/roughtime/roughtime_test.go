@@ -0,0 +1,197 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roughtime
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMessage(t *testing.T) {
+	m := message{TagNONC: bytes.Repeat([]byte{1}, 32), TagVER: uint32Value(Version), TagPATH: nil, TagZZZZ: make([]byte, 8)}
+	b, err := m.encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, err := decodeMessage(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(decoded) != len(m) {
+		t.Fatalf("expected %d tags, got %d", len(m), len(decoded))
+	}
+	for tag, v := range m {
+		if !bytes.Equal(decoded[tag], v) {
+			t.Fatalf("expected %s to be %x, got %x", tag, v, decoded[tag])
+		}
+	}
+
+	if _, err := (message{TagNONC: []byte{1}}).encode(); err == nil {
+		t.Fatal("expected error for a value that is not a multiple of 4 bytes")
+	}
+	for name, b := range map[string][]byte{
+		"too short":       {1, 0},
+		"too many tags":   {0xff, 0, 0, 0, 0, 0, 0, 0},
+		"unordered tags":  append(uint32Value(2), append(uint32Value(0), append(uint32Value(uint32(TagVER)), uint32Value(uint32(TagSIG))...)...)...),
+		"offset past end": append(uint32Value(2), append(uint32Value(8), append(uint32Value(uint32(TagSIG)), uint32Value(uint32(TagVER))...)...)...),
+	} {
+		if _, err := decodeMessage(b); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func newResponder(t *testing.T) *Responder {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewResponder(key, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating responder: %v", err)
+	}
+	return r
+}
+
+func TestRespond(t *testing.T) {
+	r := newResponder(t)
+	now := time.Now()
+
+	request, _, err := CreateRequest(rand.Reader, r.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(request) != MinRequestSize {
+		t.Fatalf("expected a request of %d bytes, got %d", MinRequestSize, len(request))
+	}
+	response, err := r.Respond(request, now, 1500*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error responding: %v", err)
+	}
+	if len(response) > len(request) {
+		t.Fatalf("response of %d bytes is larger than the request", len(response))
+	}
+	rt, err := VerifyResponse(request, response, r.PublicKey())
+	if err != nil {
+		t.Fatalf("unexpected error verifying response: %v", err)
+	}
+	if rt.Midpoint.Unix() != now.Unix() || rt.Radius != 2*time.Second {
+		t.Fatalf("unexpected time %+v", rt)
+	}
+	if !rt.Earliest().Before(now) || !rt.Latest().After(now) {
+		t.Fatalf("expected %v to be within %+v", now, rt)
+	}
+
+	// the delegation is reused until half of its validity has passed
+	first := r.cert
+	if _, err := r.Respond(request, now.Add(20*time.Minute), time.Second); err != nil || r.cert != first {
+		t.Fatalf("expected the delegation to be reused, err: %v", err)
+	}
+	if _, err := r.Respond(request, now.Add(40*time.Minute), time.Second); err != nil || r.cert == first {
+		t.Fatalf("expected the delegation to be replaced, err: %v", err)
+	}
+
+	// requests without a server ID are answered by any server
+	anyServer, _, err := CreateRequest(rand.Reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Respond(anyServer, now, time.Second); err != nil {
+		t.Fatalf("unexpected error responding: %v", err)
+	}
+}
+
+func TestRespondInvalidRequests(t *testing.T) {
+	r := newResponder(t)
+	other := newResponder(t)
+	otherServer, _, _ := CreateRequest(rand.Reader, other.PublicKey())
+	oldVersion, _ := packet(message{TagVER: uint32Value(0x80000001), TagNONC: make([]byte, NonceSize), TagZZZZ: make([]byte, MinRequestSize)})
+	noNonce, _ := packet(message{TagVER: uint32Value(Version), TagZZZZ: make([]byte, MinRequestSize)})
+	short, _, _ := CreateRequest(rand.Reader, nil)
+
+	for _, tc := range []struct {
+		name    string
+		request []byte
+		err     error
+	}{
+		{"other server", otherServer, ErrUnknownServer},
+		{"old version", oldVersion, ErrUnsupportedVersion},
+		{"no nonce", noNonce, nil},
+		{"short", short[:MinRequestSize-4], nil},
+		{"garbage", make([]byte, MinRequestSize), nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := r.Respond(tc.request, time.Now(), time.Second)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if _, err := NewResponder(ecKey, time.Hour); err == nil {
+		t.Fatal("expected error for an ECDSA key")
+	}
+}
+
+func TestVerifyResponse(t *testing.T) {
+	r := newResponder(t)
+	request, _, _ := CreateRequest(rand.Reader, nil)
+	response, err := r.Respond(request, time.Now(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherRequest, _, _ := CreateRequest(rand.Reader, nil)
+	if _, err := VerifyResponse(otherRequest, response, r.PublicKey()); err == nil {
+		t.Fatal("expected error for a response to another request")
+	}
+	if _, err := VerifyResponse(request, response, newResponder(t).PublicKey()); err == nil {
+		t.Fatal("expected error for another long-term key")
+	}
+
+	// a tampered midpoint breaks the signature
+	resp, _ := parsePacket(response)
+	srep, _ := resp.message(TagSREP)
+	srep[TagMIDP] = uint64Value(0)
+	resp[TagSREP], _ = srep.encode()
+	tampered, _ := packet(resp)
+	if _, err := VerifyResponse(request, tampered, r.PublicKey()); err == nil {
+		t.Fatal("expected error for a tampered response")
+	}
+
+	// a response to a batch of requests is verified along its Merkle path
+	sibling := leafHash(otherRequest)
+	resp, _ = parsePacket(response)
+	resp[TagPATH] = sibling
+	if err := verifyPath(request, resp, nodeHash(leafHash(request), sibling)); err != nil {
+		t.Fatalf("unexpected error verifying path: %v", err)
+	}
+	resp[TagINDX] = uint32Value(1)
+	if err := verifyPath(request, resp, nodeHash(leafHash(request), sibling)); err == nil {
+		t.Fatal("expected error for the wrong index")
+	}
+}
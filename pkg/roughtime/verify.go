@@ -0,0 +1,175 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roughtime
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// Time is the time of a server: the true time was within Radius of Midpoint
+// when the server answered.
+type Time struct {
+	Midpoint time.Time
+	Radius   time.Duration
+}
+
+// Earliest returns the earliest time the server could have answered.
+func (t Time) Earliest() time.Time {
+	return t.Midpoint.Add(-t.Radius)
+}
+
+// Latest returns the latest time the server could have answered.
+func (t Time) Latest() time.Time {
+	return t.Midpoint.Add(t.Radius)
+}
+
+// VerifyResponse verifies a response to a request packet, signed by a
+// delegated key certified by the long-term key publicKey, and returns the
+// time of the server.
+func VerifyResponse(request, response []byte, publicKey ed25519.PublicKey) (*Time, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key of %d bytes", len(publicKey))
+	}
+	req, err := parsePacket(request)
+	if err != nil {
+		return nil, fmt.Errorf("parsing request: %w", err)
+	}
+	resp, err := parsePacket(response)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+
+	nonce, err := resp.get(TagNONC, NonceSize)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(nonce, req[TagNONC]) {
+		return nil, errors.New("response is for another request")
+	}
+
+	// the delegated key must be certified by the long-term key
+	cert, err := resp.message(TagCERT)
+	if err != nil {
+		return nil, err
+	}
+	dele, err := cert.get(TagDELE, 0)
+	if err != nil {
+		return nil, err
+	}
+	certSig, err := cert.get(TagSIG, ed25519.SignatureSize)
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(publicKey, append(slices.Clone(delegationContext), dele...), certSig) {
+		return nil, errors.New("delegation is not signed by the long-term key")
+	}
+	delegation, err := decodeMessage(dele)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", TagDELE, err)
+	}
+	delegatedKey, err := delegation.get(TagPUBK, ed25519.PublicKeySize)
+	if err != nil {
+		return nil, err
+	}
+	minT, err := delegation.uint64(TagMINT)
+	if err != nil {
+		return nil, err
+	}
+	maxT, err := delegation.uint64(TagMAXT)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signed response must include the request in its Merkle tree
+	srepRaw, err := resp.get(TagSREP, 0)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := resp.get(TagSIG, ed25519.SignatureSize)
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(delegatedKey, append(slices.Clone(responseContext), srepRaw...), sig) {
+		return nil, errors.New("response is not signed by the delegated key")
+	}
+	srep, err := resp.message(TagSREP)
+	if err != nil {
+		return nil, err
+	}
+	if version, err := srep.uint32(TagVER); err != nil {
+		return nil, err
+	} else if version != Version {
+		return nil, fmt.Errorf("unsupported version %#x", version)
+	}
+	root, err := srep.get(TagROOT, hashSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyPath(request, resp, root); err != nil {
+		return nil, err
+	}
+
+	midpoint, err := srep.uint64(TagMIDP)
+	if err != nil {
+		return nil, err
+	}
+	radius, err := srep.uint32(TagRADI)
+	if err != nil {
+		return nil, err
+	}
+	if midpoint < minT || midpoint > maxT {
+		return nil, fmt.Errorf("midpoint %d is outside of the delegation window [%d, %d]", midpoint, minT, maxT)
+	}
+	if midpoint > math.MaxInt64 {
+		return nil, fmt.Errorf("invalid midpoint %d", midpoint)
+	}
+	return &Time{Midpoint: time.Unix(int64(midpoint), 0).UTC(), Radius: time.Duration(radius) * time.Second}, nil
+}
+
+// verifyPath checks that the Merkle path of a response leads from the
+// request to the signed root.
+func verifyPath(request []byte, resp message, root []byte) error {
+	index, err := resp.uint32(TagINDX)
+	if err != nil {
+		return err
+	}
+	path, err := resp.get(TagPATH, 0)
+	if err != nil {
+		return err
+	}
+	if len(path)%hashSize != 0 || len(path) > 32*hashSize {
+		return fmt.Errorf("invalid %s of %d bytes", TagPATH, len(path))
+	}
+	h := leafHash(request)
+	for i := 0; i < len(path); i += hashSize {
+		if index&1 == 0 {
+			h = nodeHash(h, path[i:i+hashSize])
+		} else {
+			h = nodeHash(path[i:i+hashSize], h)
+		}
+		index >>= 1
+	}
+	if index != 0 || !bytes.Equal(h, root) {
+		return errors.New("request is not in the signed Merkle tree")
+	}
+	return nil
+}
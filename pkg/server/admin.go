@@ -0,0 +1,117 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+// ReloadFunc reloads the server configuration.
+type ReloadFunc func(ctx context.Context) error
+
+// NewAdminServer creates a server for metrics, profiling and runtime controls
+// of the timestamp server:
+//
+//	GET      /metrics                 prometheus metrics
+//	GET      /debug/pprof/...         profiles, including profile, trace and heap
+//	GET, PUT /admin/loglevel          the log level, e.g. {"level":"debug"}
+//	GET      /admin/drain             whether issuance is drained
+//	POST     /admin/drain             stop issuing timestamps and fail readiness
+//	POST     /admin/resume            resume issuing timestamps
+//	POST     /admin/reload            reload the server configuration
+//
+// If token is not empty, every request must carry it as a bearer token. The
+// reload endpoint is not served if reload is nil.
+func NewAdminServer(addr string, readTimeout, writeTimeout time.Duration, token string, reload ReloadFunc) *http.Server {
+	mux := http.NewServeMux()
+
+	mux.Handle("GET /metrics", promhttp.Handler())
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.Handle("/admin/loglevel", log.Level)
+	mux.HandleFunc("GET /admin/drain", drainStatus)
+	mux.HandleFunc("POST /admin/drain", func(w http.ResponseWriter, r *http.Request) {
+		api.Drain()
+		log.Logger.Warn("timestamp issuance drained")
+		drainStatus(w, r)
+	})
+	mux.HandleFunc("POST /admin/resume", func(w http.ResponseWriter, r *http.Request) {
+		api.Resume()
+		log.Logger.Info("timestamp issuance resumed")
+		drainStatus(w, r)
+	})
+	if reload != nil {
+		mux.HandleFunc("POST /admin/reload", func(w http.ResponseWriter, r *http.Request) {
+			if err := reload(r.Context()); err != nil {
+				log.Logger.Errorf("reloading configuration: %v", err)
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]bool{"reloaded": true})
+		})
+	}
+
+	var handler http.Handler = mux
+	if token != "" {
+		handler = bearerAuth(token, mux)
+	}
+
+	return &http.Server{
+		Addr:         addr,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+		Handler:      handler,
+	}
+}
+
+func drainStatus(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]bool{"draining": api.Draining()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// bearerAuth rejects requests that do not carry token as a bearer token.
+func bearerAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="timestamp-server admin"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
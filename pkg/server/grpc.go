@@ -0,0 +1,40 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/generated/protobuf"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+)
+
+// NewGRPCServer creates a server for serving the gRPC TSA service on top of
+// the issuer configured for the rest API. The server uses TLS when tlsConfig
+// is set.
+func NewGRPCServer(tlsConfig *tls.Config) *grpc.Server {
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(tracing.UnaryServerInterceptor)}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
+	protobuf.RegisterTimestampServiceServer(server, api.TimestampService{})
+	return server
+}
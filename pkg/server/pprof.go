@@ -21,7 +21,7 @@ import (
 )
 
 // NewPprofServer creates a server for handling pprof
-func NewPprofServer(readTimeout, writeTimeout time.Duration) *http.Server {
+func NewPprofServer(addr string, readTimeout, writeTimeout time.Duration) *http.Server {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -29,7 +29,7 @@ func NewPprofServer(readTimeout, writeTimeout time.Duration) *http.Server {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 
 	return &http.Server{
-		Addr:         ":6060",
+		Addr:         addr,
 		ReadTimeout:  readTimeout,
 		WriteTimeout: writeTimeout,
 		Handler:      mux,
@@ -22,9 +22,9 @@ import (
 )
 
 // NewPrometheusServer creates a server for serving prometheus metrics
-func NewPrometheusServer(readTimeout, writeTimeout time.Duration) *http.Server {
+func NewPrometheusServer(addr string, readTimeout, writeTimeout time.Duration) *http.Server {
 	return &http.Server{
-		Addr:         ":2112",
+		Addr:         addr,
 		ReadTimeout:  readTimeout,
 		WriteTimeout: writeTimeout,
 		Handler:      promhttp.Handler(),
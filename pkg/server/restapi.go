@@ -22,14 +22,18 @@ import (
 	"github.com/sigstore/timestamp-authority/pkg/generated/restapi"
 	"github.com/sigstore/timestamp-authority/pkg/generated/restapi/operations"
 	"github.com/sigstore/timestamp-authority/pkg/internal/cmdparams"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
 )
 
 // NewRestAPIServer creates a server for serving the rest API TSA service
+// on top of the provided issuer
 func NewRestAPIServer(host string,
 	port int,
 	scheme []string,
 	httpReadOnly bool,
-	readTimeout, writeTimeout time.Duration) *restapi.Server {
+	readTimeout, writeTimeout time.Duration,
+	tsaIssuer *issuer.Issuer,
+	apiOpts ...api.Option) *restapi.Server {
 	doc, _ := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
 	server := restapi.NewServer(operations.NewTimestampServerAPI(doc))
 
@@ -38,8 +42,10 @@ func NewRestAPIServer(host string,
 	server.EnabledListeners = scheme
 	server.ReadTimeout = readTimeout
 	server.WriteTimeout = writeTimeout
+	server.TLSReadTimeout = readTimeout
+	server.TLSWriteTimeout = writeTimeout
 	cmdparams.IsHTTPPingOnly = httpReadOnly
-	api.ConfigureAPI()
+	api.ConfigureAPI(tsaIssuer, apiOpts...)
 	server.ConfigureAPI()
 
 	return server
@@ -0,0 +1,144 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/sigstore/timestamp-authority/pkg/roughtime"
+)
+
+// maxRoughtimeRequestSize is the largest UDP payload.
+const maxRoughtimeRequestSize = 65507
+
+var errRoughtimeUnsynced = errors.New("local time is not in sync with the NTP servers")
+
+// RoughtimeOptions configures a Roughtime responder.
+type RoughtimeOptions struct {
+	// Signer is the long-term Ed25519 key certifying the delegated keys.
+	Signer crypto.Signer
+	// Radius is the accuracy claimed in responses, rounded up to seconds.
+	Radius time.Duration
+	// DelegationValidity is how long a delegated key is certified for.
+	DelegationValidity time.Duration
+	// Clock provides the local time. Defaults to issuer.SystemClock.
+	Clock issuer.Clock
+	// ClockOffset is the offset of the local clock from the NTP servers.
+	// Requests are dropped while no offset is known, as clients cannot tell
+	// a response from an unsynchronized clock. Optional.
+	ClockOffset issuer.ClockOffset
+	// CorrectTime adds the offset, capped to MaxClockCorrection, to the
+	// local time, as the issuer does. MaxClockCorrection defaults to
+	// issuer.DefaultMaxClockCorrection.
+	CorrectTime        bool
+	MaxClockCorrection time.Duration
+}
+
+// RoughtimeServer answers Roughtime requests over UDP.
+type RoughtimeServer struct {
+	responder *roughtime.Responder
+	opts      RoughtimeOptions
+}
+
+// NewRoughtimeServer creates a Roughtime responder.
+func NewRoughtimeServer(opts RoughtimeOptions) (*RoughtimeServer, error) {
+	if opts.MaxClockCorrection < 0 {
+		return nil, errors.New("maximum clock correction must not be negative")
+	}
+	if opts.MaxClockCorrection == 0 {
+		opts.MaxClockCorrection = issuer.DefaultMaxClockCorrection
+	}
+	responder, err := roughtime.NewResponder(opts.Signer, opts.DelegationValidity)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Clock == nil {
+		opts.Clock = issuer.SystemClock{}
+	}
+	return &RoughtimeServer{responder: responder, opts: opts}, nil
+}
+
+// PublicKey returns the long-term public key clients verify responses with.
+func (s *RoughtimeServer) PublicKey() ed25519.PublicKey {
+	return s.responder.PublicKey()
+}
+
+// Serve answers the requests received on conn until it is closed. Invalid
+// requests are dropped without a response.
+func (s *RoughtimeServer) Serve(conn net.PacketConn) error {
+	buf := make([]byte, maxRoughtimeRequestSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		resp, err := s.respond(buf[:n])
+		if err != nil {
+			log.Logger.Debugf("dropping roughtime request from %v: %v", addr, err)
+			continue
+		}
+		if _, err := conn.WriteTo(resp, addr); err != nil {
+			log.Logger.Debugf("writing roughtime response to %v: %v", addr, err)
+		}
+	}
+}
+
+// respond answers a request with the local time, corrected and gated by the
+// NTP monitor, recording its outcome and signing latency.
+func (s *RoughtimeServer) respond(request []byte) (resp []byte, err error) {
+	defer func() {
+		outcome := "responded"
+		switch {
+		case err == nil:
+		case errors.Is(err, errRoughtimeUnsynced):
+			outcome = "unsynced"
+		case errors.Is(err, roughtime.ErrUnsupportedVersion):
+			outcome = "unsupported_version"
+		case errors.Is(err, roughtime.ErrUnknownServer):
+			outcome = "unknown_server"
+		default:
+			outcome = "invalid"
+		}
+		api.MetricRoughtimeRequests.With(map[string]string{"outcome": outcome}).Inc()
+	}()
+
+	now := s.opts.Clock.Now()
+	if s.opts.ClockOffset != nil {
+		if _, ok := s.opts.ClockOffset.Offset(); !ok {
+			return nil, errRoughtimeUnsynced
+		}
+		if s.opts.CorrectTime {
+			now = issuer.CorrectTime(now, s.opts.ClockOffset, s.opts.MaxClockCorrection)
+		}
+	}
+
+	start := time.Now()
+	resp, err = s.responder.Respond(request, now, s.opts.Radius)
+	if err == nil {
+		api.MetricSigningLatency.With(map[string]string{"backend": "roughtime"}).Observe(float64(time.Since(start)))
+	}
+	return resp, err
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/restapi"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+// SocketOptions configures the unix listener of the rest API server.
+type SocketOptions struct {
+	// Path of the socket.
+	Path string
+	// Mode is the permission of the socket.
+	Mode os.FileMode
+	// Owner and Group of the socket, as names or numeric IDs. Unchanged when empty.
+	Owner string
+	Group string
+}
+
+// Listen opens the listeners of the rest API server. If the unix scheme is
+// enabled, a stale socket left at the socket path by a server that did not
+// shut down cleanly is replaced, and the socket is given the configured mode
+// and owner before any request is served.
+func Listen(server *restapi.Server, socket SocketOptions) error {
+	if !slices.Contains(server.EnabledListeners, "unix") {
+		return server.Listen()
+	}
+
+	server.SocketPath = socket.Path
+	if err := removeStaleSocket(socket.Path); err != nil {
+		return err
+	}
+	if err := server.Listen(); err != nil {
+		return err
+	}
+	if err := os.Chmod(socket.Path, socket.Mode); err != nil {
+		return err
+	}
+	if socket.Owner == "" && socket.Group == "" {
+		return nil
+	}
+	uid, gid := -1, -1
+	if socket.Owner != "" {
+		u, err := lookupID(socket.Owner, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		})
+		if err != nil {
+			return fmt.Errorf("socket owner: %w", err)
+		}
+		uid = u
+	}
+	if socket.Group != "" {
+		g, err := lookupID(socket.Group, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		})
+		if err != nil {
+			return fmt.Errorf("socket group: %w", err)
+		}
+		gid = g
+	}
+	return os.Chown(socket.Path, uid, gid)
+}
+
+// lookupID returns nameOrID if it is numeric, and otherwise looks it up.
+func lookupID(nameOrID string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return id, nil
+	}
+	id, err := lookup(nameOrID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(id)
+}
+
+// removeStaleSocket removes the socket at path if no server accepts
+// connections on it. It fails if path is not a socket, or is in use.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		conn.Close()
+		return fmt.Errorf("socket %s is in use by another server", path)
+	}
+	log.Logger.Infof("removing stale socket %s", path)
+	return os.Remove(path)
+}
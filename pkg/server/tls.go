@@ -0,0 +1,212 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/generated/restapi"
+	"github.com/sigstore/timestamp-authority/pkg/internal/cmdparams"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+// Client certificate policies.
+const (
+	// ClientAuthNone does not ask for client certificates.
+	ClientAuthNone = "none"
+	// ClientAuthOptional verifies client certificates if any is sent.
+	ClientAuthOptional = "optional"
+	// ClientAuthRequire requires a verified client certificate.
+	ClientAuthRequire = "require"
+)
+
+// TLSVersions maps the supported minimum TLS versions to their identifiers.
+var TLSVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// certificateCheckInterval is how often the serving certificate and key files
+// are checked for changes.
+const certificateCheckInterval = 10 * time.Second
+
+// TLSOptions configures TLS on a listener.
+type TLSOptions struct {
+	// Certificate and Key are the paths to the PEM-encoded serving certificate
+	// and key. They are reloaded when either file changes.
+	Certificate string
+	Key         string
+	// ClientCA is the path to the PEM-encoded CA verifying client certificates.
+	ClientCA string
+	// ClientAuth is one of ClientAuthNone, ClientAuthOptional or
+	// ClientAuthRequire. Defaults to ClientAuthRequire if ClientCA is set, and
+	// ClientAuthNone otherwise.
+	ClientAuth string
+	// MinVersion is the minimum TLS version, one of the keys of TLSVersions.
+	// Defaults to 1.2.
+	MinVersion string
+}
+
+// NewTLSConfig returns a TLS configuration for a server. It fails if the
+// serving certificate cannot be loaded or has expired, or if the options
+// would leave client certificates unverified.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	minVersion := uint16(tls.VersionTLS12)
+	if opts.MinVersion != "" {
+		v, ok := TLSVersions[opts.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported minimum TLS version %q, expected 1.2 or 1.3", opts.MinVersion)
+		}
+		minVersion = v
+	}
+
+	reloader, err := NewCertificateReloader(opts.Certificate, opts.Key)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		MinVersion:     minVersion,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	clientAuth := opts.ClientAuth
+	if clientAuth == "" {
+		clientAuth = ClientAuthNone
+		if opts.ClientCA != "" {
+			clientAuth = ClientAuthRequire
+		}
+	}
+	switch clientAuth {
+	case ClientAuthNone:
+		if opts.ClientCA != "" {
+			return nil, errors.New("a client CA is set, but client certificates are not verified")
+		}
+		return cfg, nil
+	case ClientAuthOptional:
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unsupported client authentication %q, expected one of [none, optional, require]", clientAuth)
+	}
+	if opts.ClientCA == "" {
+		return nil, fmt.Errorf("client authentication %q requires a client CA", clientAuth)
+	}
+	pem, err := os.ReadFile(filepath.Clean(opts.ClientCA))
+	if err != nil {
+		return nil, err
+	}
+	cfg.ClientCAs = x509.NewCertPool()
+	if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in client CA %s", opts.ClientCA)
+	}
+	return cfg, nil
+}
+
+// ConfigureTLS configures the https listener of the rest API server.
+func ConfigureTLS(server *restapi.Server, opts TLSOptions) error {
+	cfg, err := NewTLSConfig(opts)
+	if err != nil {
+		return err
+	}
+	server.TLSCertificate = opts.Certificate
+	server.TLSCertificateKey = opts.Key
+	server.TLSCACertificate = opts.ClientCA
+	cmdparams.TLSConfig = cfg
+	return nil
+}
+
+// CertificateReloader serves a certificate and key read from files, and
+// reloads them when either file changes. If the new files cannot be loaded,
+// for instance while only one of them has been replaced, the previous
+// certificate is served until they can.
+type CertificateReloader struct {
+	certPath, keyPath string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	modTimes [2]time.Time
+	checked  time.Time
+}
+
+// NewCertificateReloader loads the certificate and key, failing if they do
+// not match or the certificate has expired.
+func NewCertificateReloader(certPath, keyPath string) (*CertificateReloader, error) {
+	r := &CertificateReloader{certPath: certPath, keyPath: keyPath}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CertificateReloader) load() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("loading TLS certificate: %w", err)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return fmt.Errorf("parsing TLS certificate: %w", err)
+	}
+	if time.Now().After(cert.Leaf.NotAfter) {
+		return fmt.Errorf("TLS certificate %s expired at %v", r.certPath, cert.Leaf.NotAfter)
+	}
+	r.cert, r.modTimes, r.checked = &cert, modTimes, time.Now()
+	return nil
+}
+
+func (r *CertificateReloader) stat() ([2]time.Time, error) {
+	var modTimes [2]time.Time
+	for i, path := range []string{r.certPath, r.keyPath} {
+		fi, err := os.Stat(filepath.Clean(path))
+		if err != nil {
+			return modTimes, err
+		}
+		modTimes[i] = fi.ModTime()
+	}
+	return modTimes, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if the
+// files changed. It is meant to be used as tls.Config.GetCertificate.
+func (r *CertificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checked) < certificateCheckInterval {
+		return r.cert, nil
+	}
+	r.checked = time.Now()
+	if modTimes, err := r.stat(); err == nil && modTimes == r.modTimes {
+		return r.cert, nil
+	}
+	if err := r.load(); err != nil {
+		log.Logger.Errorf("reloading TLS certificate, serving the previous one: %v", err)
+		return r.cert, nil
+	}
+	log.Logger.Infof("reloaded TLS certificate %s", r.certPath)
+	return r.cert, nil
+}
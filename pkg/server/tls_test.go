@@ -0,0 +1,179 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+// writeCert creates a certificate for cn, signed by parent or self-signed
+// if parent is nil, and writes it and its key to dir.
+func writeCert(t *testing.T, dir, cn string, notAfter time.Time, parent *tls.Certificate) (certPath, keyPath string, cert tls.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-2 * time.Hour),
+		NotAfter:              notAfter,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	issuer, signer := tmpl, any(key)
+	if parent != nil {
+		issuer, signer = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, key.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, _ := x509.ParseCertificate(der)
+	certPEM, _ := cryptoutils.MarshalCertificateToPEM(leaf)
+	keyPEM, _ := cryptoutils.MarshalPrivateKeyToPEM(key)
+	certPath, keyPath = filepath.Join(dir, cn+".crt"), filepath.Join(dir, cn+".key")
+	if err := os.WriteFile(certPath, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certPath, keyPath, tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestNewTLSConfigRejectsInsecureOptions(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath, _ := writeCert(t, dir, "server", time.Now().Add(time.Hour), nil)
+	caPath, _, _ := writeCert(t, dir, "ca", time.Now().Add(time.Hour), nil)
+	expiredPath, expiredKeyPath, _ := writeCert(t, dir, "expired", time.Now().Add(-time.Hour), nil)
+
+	for name, opts := range map[string]TLSOptions{
+		"old version":         {Certificate: certPath, Key: keyPath, MinVersion: "1.1"},
+		"unverified CA":       {Certificate: certPath, Key: keyPath, ClientCA: caPath, ClientAuth: ClientAuthNone},
+		"required without CA": {Certificate: certPath, Key: keyPath, ClientAuth: ClientAuthRequire},
+		"optional without CA": {Certificate: certPath, Key: keyPath, ClientAuth: ClientAuthOptional},
+		"mismatched key":      {Certificate: certPath, Key: expiredKeyPath},
+		"expired certificate": {Certificate: expiredPath, Key: expiredKeyPath},
+	} {
+		if _, err := NewTLSConfig(opts); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	cfg, err := NewTLSConfig(TLSOptions{Certificate: certPath, Key: keyPath, ClientCA: caPath, MinVersion: "1.3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ClientAuth != tls.RequireAndVerifyClientCert || cfg.MinVersion != tls.VersionTLS13 {
+		t.Fatalf("expected client certificates to be required over TLS 1.3, got %v %x", cfg.ClientAuth, cfg.MinVersion)
+	}
+}
+
+func TestNewTLSConfigRequiresClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	caPath, _, ca := writeCert(t, dir, "ca", time.Now().Add(time.Hour), nil)
+	certPath, keyPath, _ := writeCert(t, dir, "server", time.Now().Add(time.Hour), &ca)
+	_, _, clientCert := writeCert(t, dir, "client", time.Now().Add(time.Hour), &ca)
+
+	cfg, err := NewTLSConfig(TLSOptions{Certificate: certPath, Key: keyPath, ClientCA: caPath, ClientAuth: ClientAuthRequire})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	// StartTLS would add a certificate of its own, served instead of GetCertificate's
+	server.Listener = tls.NewListener(server.Listener, cfg)
+	server.Start()
+	t.Cleanup(server.Close)
+	url := strings.Replace(server.URL, "http://", "https://", 1)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Leaf)
+	get := func(certs ...tls.Certificate) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs, MinVersion: tls.VersionTLS12}}}
+		resp, err := client.Get(url)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	if err := get(); err == nil {
+		t.Fatal("expected request without a client certificate to fail")
+	}
+	if err := get(clientCert); err != nil {
+		t.Fatalf("unexpected error with a client certificate: %v", err)
+	}
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath, first := writeCert(t, dir, "server", time.Now().Add(time.Hour), nil)
+	r, err := NewCertificateReloader(certPath, keyPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// rotate the certificate, forcing the files to look modified
+	_, _, second := writeCert(t, dir, "server", time.Now().Add(time.Hour), nil)
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{certPath, keyPath} {
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cert, _ := r.GetCertificate(nil)
+	if !cert.Leaf.Equal(first.Leaf) {
+		t.Fatal("expected files not to be checked again before the check interval")
+	}
+	r.checked = time.Time{}
+	cert, _ = r.GetCertificate(nil)
+	if !cert.Leaf.Equal(second.Leaf) {
+		t.Fatal("expected the rotated certificate to be served")
+	}
+
+	// a broken key keeps the previous certificate
+	if err := os.WriteFile(keyPath, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r.checked = time.Time{}
+	cert, _ = r.GetCertificate(nil)
+	if !cert.Leaf.Equal(second.Leaf) {
+		t.Fatal("expected the previous certificate to be served while the files are invalid")
+	}
+}
@@ -0,0 +1,305 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spool timestamps requests exchanged as files, for air-gapped
+// deployments where requests and responses are carried on removable media.
+//
+// Requests are DER-encoded TimeStampReq files with the .tsq extension, placed
+// in the input directory. Each is passed to the issuer like a request to the
+// RFC 3161 HTTP transport, and is either answered with a .tsr file of the same
+// name in the output directory, or moved to the error directory alongside a
+// .reason file explaining why it was rejected.
+package spool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+)
+
+const (
+	RequestExt  = ".tsq"
+	ResponseExt = ".tsr"
+	ReasonExt   = ".reason"
+
+	// DefaultInterval is how often the input directory is scanned by default.
+	DefaultInterval = 2 * time.Second
+
+	// Client identifies requests read from the spool in issuance events. The
+	// name of the request file is recorded as the request ID.
+	Client = "spool"
+)
+
+// Options configures a Spooler.
+type Options struct {
+	// In is the directory scanned for requests.
+	In string
+	// Out is the directory responses are written to.
+	Out string
+	// Errors is the directory rejected requests are moved to. Defaults to the
+	// errors directory inside Out, and is created if missing.
+	Errors string
+	// Interval is how often In is scanned by Run. Defaults to DefaultInterval.
+	Interval time.Duration
+}
+
+// fileState is the size and modification time of a request seen by a scan.
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// Spooler answers the requests placed in a directory.
+type Spooler struct {
+	issuer *issuer.Issuer
+	opts   Options
+	// seen holds the requests found by the previous scan, so that requests
+	// still being copied into In are left alone until they stop changing
+	seen map[string]fileState
+}
+
+// New creates a Spooler issuing timestamps with i.
+func New(i *issuer.Issuer, opts Options) (*Spooler, error) {
+	if i == nil {
+		return nil, errors.New("an issuer is required")
+	}
+	if opts.Errors == "" {
+		opts.Errors = filepath.Join(opts.Out, "errors")
+	}
+	if opts.Interval == 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Interval < 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	for _, dir := range []string{opts.In, opts.Out} {
+		if err := checkDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(opts.Errors, 0o750); err != nil {
+		return nil, fmt.Errorf("creating error directory: %w", err)
+	}
+	return &Spooler{issuer: i, opts: opts, seen: map[string]fileState{}}, nil
+}
+
+func checkDir(dir string) error {
+	if dir == "" {
+		return errors.New("input and output directories are required")
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	return nil
+}
+
+// Run scans the input directory at every interval until the context is done.
+func (s *Spooler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Scan(ctx); err != nil {
+			log.Logger.Errorf("scanning spool directory: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan answers the requests that have not changed since the previous scan,
+// returning the number of requests answered or rejected.
+func (s *Spooler) Scan(ctx context.Context) (int, error) {
+	return s.scan(ctx, false)
+}
+
+// Flush answers every request in the input directory, whether or not it was
+// seen by a previous scan. It must only be used once every request has been
+// completely written.
+func (s *Spooler) Flush(ctx context.Context) (int, error) {
+	return s.scan(ctx, true)
+}
+
+func (s *Spooler) scan(ctx context.Context, all bool) (int, error) {
+	entries, err := os.ReadDir(s.opts.In)
+	if err != nil {
+		return 0, err
+	}
+	seen := make(map[string]fileState, len(entries))
+	processed := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		// copy tools write to hidden temporary files before renaming them
+		if !entry.Type().IsRegular() || strings.HasPrefix(name, ".") || filepath.Ext(name) != RequestExt {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue // removed since the directory was read
+		}
+		state := fileState{size: info.Size(), modTime: info.ModTime()}
+		if prev, ok := s.seen[name]; !all && (!ok || prev != state) {
+			seen[name] = state
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+		if err := s.process(ctx, name); err != nil {
+			// left in place, to be retried by the next scan
+			log.Logger.Errorf("processing %s: %v", name, err)
+			continue
+		}
+		processed++
+	}
+	s.seen = seen
+	return processed, nil
+}
+
+// process answers or rejects a request. An error is only returned if the
+// request could not be read or its outcome could not be written, in which
+// case the request is left in the input directory.
+func (s *Spooler) process(ctx context.Context, name string) error {
+	ctx = issuer.WithClient(ctx, Client)
+	ctx = issuer.WithRequestID(ctx, name)
+	base := strings.TrimSuffix(name, RequestExt)
+	in := filepath.Join(s.opts.In, name)
+	out := filepath.Join(s.opts.Out, base+ResponseExt)
+
+	f, err := os.Open(filepath.Clean(in))
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(io.LimitReader(f, issuer.MaxRequestSize+1))
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if len(body) > issuer.MaxRequestSize {
+		return s.reject(name, s.issuer.Reject(ctx, timestamp.BadRequest, "Timestamp request is too large",
+			fmt.Errorf("request exceeds %d bytes", issuer.MaxRequestSize)))
+	}
+	// never overwrite a response that may not have been collected yet
+	if _, err := os.Stat(out); err == nil {
+		if answered(body, out) {
+			log.Logger.Infof("%s was already timestamped, removing it", name)
+			return os.Remove(in)
+		}
+		return s.reject(name, s.issuer.Reject(ctx, timestamp.BadRequest, "A response with the same name already exists",
+			fmt.Errorf("%s already exists", out)))
+	}
+
+	resp, err := s.issuer.IssueDER(ctx, body)
+	if err != nil {
+		return s.reject(name, err)
+	}
+	if err := writeFile(out, resp, 0o644); err != nil {
+		return err
+	}
+	if err := os.Remove(in); err != nil {
+		return err
+	}
+	log.Logger.Infof("timestamped %s", name)
+	return nil
+}
+
+// answered reports whether the response at out answers the request in body,
+// as happens when the spooler stops between writing a response and removing
+// its request.
+func answered(body []byte, out string) bool {
+	req, err := timestamp.ParseRequest(body)
+	if err != nil {
+		return false
+	}
+	resp, err := os.ReadFile(filepath.Clean(out))
+	if err != nil {
+		return false
+	}
+	ts, err := timestamp.ParseResponse(resp)
+	if err != nil {
+		return false
+	}
+	if (req.Nonce == nil) != (ts.Nonce == nil) || (req.Nonce != nil && req.Nonce.Cmp(ts.Nonce) != 0) {
+		return false
+	}
+	return ts.HashAlgorithm == req.HashAlgorithm && bytes.Equal(ts.HashedMessage, req.HashedMessage)
+}
+
+// reject moves a request to the error directory, next to a file giving the
+// reason it was rejected.
+func (s *Spooler) reject(name string, err error) error {
+	base := strings.TrimSuffix(name, RequestExt)
+	reason := fmt.Sprintf("request: %s\ntime: %s\n", name, time.Now().UTC().Format(time.RFC3339))
+	var ierr *issuer.Error
+	if errors.As(err, &ierr) {
+		reason += fmt.Sprintf("failure: %s\nmessage: %s\n", ierr.Reason(), ierr.Message)
+		if ierr.Err != nil {
+			reason += fmt.Sprintf("error: %v\n", ierr.Err)
+		}
+	} else {
+		reason += fmt.Sprintf("error: %v\n", err)
+	}
+	if werr := writeFile(filepath.Join(s.opts.Errors, base+ReasonExt), []byte(reason), 0o644); werr != nil {
+		return werr
+	}
+	if merr := move(filepath.Join(s.opts.In, name), filepath.Join(s.opts.Errors, name)); merr != nil {
+		return merr
+	}
+	log.Logger.Warnf("rejected %s: %v", name, err)
+	return nil
+}
+
+// writeFile writes a file atomically, so that a partial file is never
+// picked up from the output directory.
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// move renames a file, copying it when the directories are on different
+// filesystems.
+func move(from, to string) error {
+	if err := os.Rename(from, to); err == nil {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Clean(from))
+	if err != nil {
+		return err
+	}
+	if err := writeFile(to, data, 0o644); err != nil {
+		return err
+	}
+	return os.Remove(from)
+}
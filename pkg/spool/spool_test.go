@@ -0,0 +1,154 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spool
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+)
+
+func newSpooler(t *testing.T, observers ...issuer.Observer) (*Spooler, Options) {
+	t.Helper()
+	tsaSigner, err := signer.NewCryptoSigner(context.Background(), crypto.SHA256, signer.MemoryScheme, "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error creating signer: %v", err)
+	}
+	certChain, err := signer.NewTimestampingCertWithChain(tsaSigner)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate chain: %v", err)
+	}
+	i, err := issuer.New(issuer.Options{Signer: tsaSigner, CertChain: certChain, Observers: observers})
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	opts := Options{In: t.TempDir(), Out: t.TempDir()}
+	s, err := New(i, opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating spooler: %v", err)
+	}
+	return s, opts
+}
+
+func writeRequest(t *testing.T, dir, name string, hash crypto.Hash) {
+	t.Helper()
+	digest := sha256.Sum256([]byte(name))
+	req := &timestamp.Request{HashAlgorithm: hash, HashedMessage: digest[:hash.Size()]}
+	der, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), der, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	var events []*issuer.Event
+	s, opts := newSpooler(t, issuer.ObserverFunc(func(_ context.Context, e *issuer.Event) {
+		events = append(events, e)
+	}))
+	ctx := context.Background()
+	errDir := filepath.Join(opts.Out, "errors")
+
+	writeRequest(t, opts.In, "good.tsq", crypto.SHA256)
+	writeRequest(t, opts.In, "weak.tsq", crypto.SHA1)
+	if err := os.WriteFile(filepath.Join(opts.In, "garbage.tsq"), []byte("garbage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(opts.In, "notes.txt"), []byte("ignored"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// requests are only answered once they stopped changing between scans
+	if n, err := s.Scan(ctx); err != nil || n != 0 {
+		t.Fatalf("expected the first scan to wait for requests to settle, got %d, %v", n, err)
+	}
+	if n, err := s.Scan(ctx); err != nil || n != 3 {
+		t.Fatalf("expected 3 requests to be processed, got %d, %v", n, err)
+	}
+
+	resp, err := os.ReadFile(filepath.Join(opts.Out, "good"+ResponseExt))
+	if err != nil {
+		t.Fatalf("expected a response: %v", err)
+	}
+	if _, err := timestamp.ParseResponse(resp); err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+
+	for name, failure := range map[string]string{"weak": "badAlg", "garbage": "badDataFormat"} {
+		if _, err := os.Stat(filepath.Join(errDir, name+RequestExt)); err != nil {
+			t.Fatalf("expected %s to be moved to the error directory: %v", name, err)
+		}
+		reason, err := os.ReadFile(filepath.Join(errDir, name+ReasonExt))
+		if err != nil || !strings.Contains(string(reason), "failure: "+failure) {
+			t.Fatalf("expected %s to be rejected with %s, got %q, %v", name, failure, reason, err)
+		}
+	}
+
+	left, _ := os.ReadDir(opts.In)
+	if len(left) != 1 || left[0].Name() != "notes.txt" {
+		t.Fatalf("expected only unrelated files to be left, got %v", left)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected an issuance event for each request, got %d", len(events))
+	}
+	for _, e := range events {
+		if e.Client != Client || !strings.HasSuffix(e.RequestID, RequestExt) {
+			t.Fatalf("expected the request file in the event, got client %q and request ID %q", e.Client, e.RequestID)
+		}
+	}
+
+	// a request whose response was written before the spooler stopped is
+	// not answered twice
+	writeRequest(t, opts.In, "good.tsq", crypto.SHA256)
+	if n, err := s.Flush(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the request to be flushed, got %d, %v", n, err)
+	}
+	if _, err := os.Stat(filepath.Join(opts.In, "good.tsq")); !os.IsNotExist(err) {
+		t.Fatalf("expected the answered request to be removed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(errDir, "good"+ReasonExt)); !os.IsNotExist(err) {
+		t.Fatalf("expected the answered request not to be rejected: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected no timestamp to be issued for the answered request, got %d events", len(events))
+	}
+
+	// a response that may not have been collected is never overwritten
+	writeRequest(t, opts.In, "other.tsq", crypto.SHA256)
+	if err := os.Rename(filepath.Join(opts.In, "other.tsq"), filepath.Join(opts.In, "good.tsq")); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.Flush(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the request to be flushed, got %d, %v", n, err)
+	}
+	if after, _ := os.ReadFile(filepath.Join(opts.Out, "good"+ResponseExt)); string(after) != string(resp) {
+		t.Fatal("expected the existing response to be kept")
+	}
+	if _, err := os.Stat(filepath.Join(errDir, "good"+ReasonExt)); err != nil {
+		t.Fatalf("expected the conflicting request to be rejected: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/client"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/log"
+	"github.com/sigstore/timestamp-authority/pkg/server"
+)
+
+func TestAdminServer(t *testing.T) {
+	url := createServer(t)
+	const token = "s3cret"
+	reload := func(context.Context) error {
+		return api.Reconfigure(newMemoryIssuer(t, issuer.Options{}))
+	}
+	adminServer := server.NewAdminServer("", 10*time.Second, 10*time.Second, token, reload)
+	admin := httptest.NewServer(adminServer.Handler)
+	t.Cleanup(admin.Close)
+	t.Cleanup(api.Resume)
+
+	do := func(method, path, body string) (int, string) {
+		t.Helper()
+		req, _ := http.NewRequest(method, admin.URL+path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error calling %s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+	status := func(path string) int {
+		t.Helper()
+		var resp *http.Response
+		var err error
+		if path == "/api/v1/timestamp" {
+			resp, err = http.Post(url+path, client.JSONMediaType, bytes.NewReader(buildJSONReq(t, []byte("blob"), crypto.SHA256, "sha256", true, nil, "")))
+		} else {
+			resp, err = http.Get(url + path)
+		}
+		if err != nil {
+			t.Fatalf("unexpected error calling %s: %v", path, err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	resp, err := http.Post(admin.URL+"/admin/drain", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected request without token to be rejected, got status code %d", resp.StatusCode)
+	}
+
+	for _, path := range []string{"/metrics", "/debug/pprof/heap", "/debug/pprof/cmdline"} {
+		if code, _ := do(http.MethodGet, path, ""); code != http.StatusOK {
+			t.Fatalf("expected %s to be served, got status code %d", path, code)
+		}
+	}
+
+	if code, body := do(http.MethodPost, "/admin/drain", ""); code != http.StatusOK || !strings.Contains(body, `"draining":true`) {
+		t.Fatalf("unexpected drain response: %d %s", code, body)
+	}
+	if code := status("/ready"); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected drained server not to be ready, got status code %d", code)
+	}
+	if code := status("/api/v1/timestamp"); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected drained server to refuse timestamps, got status code %d", code)
+	}
+	if code, body := do(http.MethodPost, "/admin/resume", ""); code != http.StatusOK || !strings.Contains(body, `"draining":false`) {
+		t.Fatalf("unexpected resume response: %d %s", code, body)
+	}
+	if code := status("/api/v1/timestamp"); code != http.StatusCreated {
+		t.Fatalf("expected resumed server to issue timestamps, got status code %d", code)
+	}
+
+	level := log.Level.Level()
+	t.Cleanup(func() { log.Level.SetLevel(level) })
+	if code, body := do(http.MethodPut, "/admin/loglevel", `{"level":"error"}`); code != http.StatusOK || !strings.Contains(body, "error") {
+		t.Fatalf("unexpected log level response: %d %s", code, body)
+	}
+	if log.Logger.Desugar().Core().Enabled(log.Level.Level() - 1) {
+		t.Fatal("expected log level to be raised")
+	}
+
+	before := api.Issuer()
+	if code, body := do(http.MethodPost, "/admin/reload", ""); code != http.StatusOK {
+		t.Fatalf("unexpected reload response: %d %s", code, body)
+	}
+	if api.Issuer() == before {
+		t.Fatal("expected reload to replace the issuer")
+	}
+}
@@ -20,21 +20,31 @@ import (
 	"crypto/sha256"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"math/big"
+	"net/http"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/digitorus/pkcs7"
 	ts "github.com/digitorus/timestamp"
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/sigstore/timestamp-authority/pkg/api"
 	"github.com/sigstore/timestamp-authority/pkg/client"
 	"github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
 	"github.com/sigstore/timestamp-authority/pkg/x509"
 
 	"github.com/go-openapi/runtime"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // TestSigner encapsulates a public key for verification
@@ -75,6 +85,216 @@ func TestGetTimestampCertChain(t *testing.T) {
 	}
 }
 
+func TestGetTimestampCertChainFormats(t *testing.T) {
+	url := createServer(t)
+
+	c, err := client.GetTimestampClient(url)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	chain, err := c.Timestamp.GetTimestampCertChain(nil)
+	if err != nil {
+		t.Fatalf("unexpected error getting timestamp chain: %v", err)
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(chain.Payload))
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling cert chain: %v", err)
+	}
+
+	get := func(accept string) (string, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, url+"/api/v1/timestamp/certchain", nil)
+		if err != nil {
+			t.Fatalf("unexpected error creating request: %v", err)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error getting cert chain: %v", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading cert chain: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code 200 for %s, got %d: %s", accept, resp.StatusCode, body)
+		}
+		return resp.Header.Get("Content-Type"), body
+	}
+
+	for _, accept := range []string{"", "*/*"} {
+		contentType, body := get(accept)
+		if contentType != "application/pem-certificate-chain" || string(body) != chain.Payload {
+			t.Fatalf("expected PEM chain by default for Accept %q, got %s", accept, contentType)
+		}
+	}
+
+	contentType, body := get("application/pkcs7-mime")
+	if contentType != "application/pkcs7-mime; smime-type=certs-only" {
+		t.Fatalf("unexpected content type %s", contentType)
+	}
+	p7, err := pkcs7.Parse(body)
+	if err != nil {
+		t.Fatalf("unexpected error parsing PKCS#7 bundle: %v", err)
+	}
+	if len(p7.Certificates) != len(certs) {
+		t.Fatalf("expected %d certificates in PKCS#7 bundle, got %d", len(certs), len(p7.Certificates))
+	}
+	for i := range certs {
+		if !p7.Certificates[i].Equal(certs[i]) {
+			t.Fatalf("unexpected certificate %d in PKCS#7 bundle", i)
+		}
+	}
+
+	contentType, body = get("application/pkix-cert")
+	if contentType != "application/pkix-cert" || !bytes.Equal(body, certs[0].Raw) {
+		t.Fatalf("expected DER leaf certificate, got %s", contentType)
+	}
+
+	contentType, body = get("application/json")
+	if contentType != "application/json" {
+		t.Fatalf("unexpected content type %s", contentType)
+	}
+	var certChain models.CertificateChain
+	if err := json.Unmarshal(body, &certChain); err != nil {
+		t.Fatalf("unexpected error unmarshalling JSON chain: %v", err)
+	}
+	if err := certChain.Validate(nil); err != nil {
+		t.Fatalf("unexpected invalid JSON chain: %v", err)
+	}
+	if len(certChain.Certificates) != len(certs) {
+		t.Fatalf("expected %d certificates in JSON chain, got %d", len(certs), len(certChain.Certificates))
+	}
+	for i, cert := range certs {
+		fingerprint := sha256.Sum256(cert.Raw)
+		got := certChain.Certificates[i]
+		if *got.Sha256Fingerprint != hex.EncodeToString(fingerprint[:]) {
+			t.Fatalf("unexpected fingerprint for certificate %d: %s", i, *got.Sha256Fingerprint)
+		}
+		if *got.Subject != cert.Subject.String() || *got.SerialNumber != cert.SerialNumber.String() {
+			t.Fatalf("unexpected subject or serial for certificate %d: %s %s", i, *got.Subject, *got.SerialNumber)
+		}
+		if *got.PublicKeyAlgorithm != "ECDSA P-256" {
+			t.Fatalf("unexpected key algorithm for certificate %d: %s", i, *got.PublicKeyAlgorithm)
+		}
+	}
+}
+
+func TestGetTimestampTrustedRoot(t *testing.T) {
+	oldChain := newMemoryIssuer(t, issuer.Options{}).CertChain()
+	rotation := time.Now().Add(-time.Hour).Truncate(time.Second)
+	url := createServerWithOptions(t, issuer.Options{},
+		api.WithHistoricalChains([]trustedroot.Chain{{Certificates: oldChain, End: rotation}}))
+
+	response, err := http.Get(url + "/api/v1/timestamp/trustedroot")
+	if err != nil {
+		t.Fatalf("unexpected error getting trusted root: %v", err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading trusted root: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code 200, got %d: %s", response.StatusCode, body)
+	}
+
+	var tr prototrustroot.TrustedRoot
+	if err := protojson.Unmarshal(body, &tr); err != nil {
+		t.Fatalf("unexpected error unmarshalling trusted root: %v", err)
+	}
+	tsas := tr.GetTimestampAuthorities()
+	if len(tsas) != 2 {
+		t.Fatalf("expected historical and current chains, got %d", len(tsas))
+	}
+	if got := tsas[0].GetValidFor().GetEnd().AsTime(); !got.Equal(rotation) {
+		t.Fatalf("expected historical chain to end at %v, got %v", rotation, got)
+	}
+	if !bytes.Equal(tsas[0].GetCertChain().GetCertificates()[0].GetRawBytes(), oldChain[0].Raw) {
+		t.Fatal("unexpected historical leaf certificate")
+	}
+	if tsas[1].GetValidFor().End != nil {
+		t.Fatal("expected current chain to have no end")
+	}
+	// defaults to the timestamp endpoint of the server
+	if got := tsas[1].GetUri(); got != url+"/api/v1/timestamp" {
+		t.Fatalf("expected URI %s/api/v1/timestamp, got %s", url, got)
+	}
+
+	url = createServerWithOptions(t, issuer.Options{}, api.WithURI("https://tsa.example.com/api/v1/timestamp"))
+	c, err := client.GetTimestampClient(url)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	resp, err := c.Timestamp.GetTimestampTrustedRoot(nil)
+	if err != nil {
+		t.Fatalf("unexpected error getting trusted root: %v", err)
+	}
+	payload, _ := json.Marshal(resp.Payload)
+	if err := protojson.Unmarshal(payload, &tr); err != nil {
+		t.Fatalf("unexpected error unmarshalling trusted root: %v", err)
+	}
+	if len(tr.GetTimestampAuthorities()) != 1 || tr.GetTimestampAuthorities()[0].GetUri() != "https://tsa.example.com/api/v1/timestamp" {
+		t.Fatalf("unexpected timestamp authorities %v", tr.GetTimestampAuthorities())
+	}
+}
+
+func TestGetTimestampInfo(t *testing.T) {
+	url := createServerWithOptions(t, issuer.Options{
+		AcceptedPolicies: []asn1.ObjectIdentifier{{1, 2, 3, 4}},
+	})
+
+	c, err := client.GetTimestampClient(url)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+
+	info, err := c.Timestamp.GetTimestampInfo(nil)
+	if err != nil {
+		t.Fatalf("unexpected error getting timestamp info: %v", err)
+	}
+	if err := info.Payload.Validate(nil); err != nil {
+		t.Fatalf("unexpected invalid timestamp info: %v", err)
+	}
+
+	if !slices.Contains(info.Payload.HashAlgorithms, "sha256") {
+		t.Fatalf("expected sha256 to be supported, got %v", info.Payload.HashAlgorithms)
+	}
+	if *info.Payload.DefaultPolicy != issuer.DefaultPolicy.String() {
+		t.Fatalf("expected default policy %s, got %s", issuer.DefaultPolicy, *info.Payload.DefaultPolicy)
+	}
+	if len(info.Payload.AcceptedPolicies) != 1 || info.Payload.AcceptedPolicies[0] != "1.2.3.4" {
+		t.Fatalf("expected accepted policy 1.2.3.4, got %v", info.Payload.AcceptedPolicies)
+	}
+	if *info.Payload.Accuracy != "1s" {
+		t.Fatalf("expected accuracy of 1s, got %s", *info.Payload.Accuracy)
+	}
+
+	chain, err := c.Timestamp.GetTimestampCertChain(nil)
+	if err != nil {
+		t.Fatalf("unexpected error getting timestamp chain: %v", err)
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(chain.Payload))
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling cert chain: %v", err)
+	}
+	if len(info.Payload.Certificates) != len(certs) {
+		t.Fatalf("expected %d certificates, got %d", len(certs), len(info.Payload.Certificates))
+	}
+	for i, cert := range certs {
+		fingerprint := sha256.Sum256(cert.Raw)
+		if got := *info.Payload.Certificates[i].Sha256Fingerprint; got != hex.EncodeToString(fingerprint[:]) {
+			t.Fatalf("unexpected fingerprint for certificate %d: %s", i, got)
+		}
+		if got := time.Time(*info.Payload.Certificates[i].NotAfter); !got.Equal(cert.NotAfter) {
+			t.Fatalf("expected certificate %d to expire at %v, got %v", i, cert.NotAfter, got)
+		}
+	}
+}
+
 type timestampTestCase struct {
 	name         string
 	reqMediaType string
@@ -430,3 +650,87 @@ func TestInvalidJSONArtifactHashNotBase64Encoded(t *testing.T) {
 		t.Fatalf("expected error to occur while parsing request")
 	}
 }
+
+func TestTimestampRequestTooLarge(t *testing.T) {
+	url := createServer(t)
+
+	resp, err := http.Post(url+"/api/v1/timestamp", client.TimestampQueryMediaType, bytes.NewReader(make([]byte, issuer.MaxRequestSize+1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413, got %d", resp.StatusCode)
+	}
+}
+
+func TestIssuanceMetrics(t *testing.T) {
+	url := createServerWithOptions(t, issuer.Options{Observers: []issuer.Observer{api.MetricsObserver}})
+
+	issued := api.MetricTimestampsIssued.With(map[string]string{
+		"policy":         issuer.DefaultPolicy.String(),
+		"hash_algorithm": "sha384",
+		"cert_req":       "true",
+	})
+	rejected := api.MetricTimestampsRejected.With(map[string]string{"reason": "badAlg"})
+	issuedBefore, rejectedBefore := testutil.ToFloat64(issued), testutil.ToFloat64(rejected)
+
+	post := func(body []byte) {
+		resp, err := http.Post(url+"/api/v1/timestamp", client.JSONMediaType, bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error requesting timestamp: %v", err)
+		}
+		resp.Body.Close()
+	}
+	post(buildJSONReq(t, []byte("blob"), crypto.SHA384, "sha384", true, nil, ""))
+	post(buildJSONReq(t, []byte("blob"), crypto.SHA1, "sha1", true, nil, ""))
+
+	if got := testutil.ToFloat64(issued) - issuedBefore; got != 1 {
+		t.Fatalf("expected 1 issued timestamp, got %v", got)
+	}
+	if got := testutil.ToFloat64(rejected) - rejectedBefore; got != 1 {
+		t.Fatalf("expected 1 rejected request, got %v", got)
+	}
+	if testutil.CollectAndCount(api.MetricSigningLatency) == 0 {
+		t.Fatal("expected signing latency to be recorded")
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	url := createServer(t)
+	if got := testutil.ToFloat64(api.MetricCertificateExpiryWarning); got != 0 {
+		t.Fatalf("expected no certificate expiry warning, got %v", got)
+	}
+
+	response, err := http.Get(url + "/ready")
+	if err != nil {
+		t.Fatalf("unexpected error getting readiness: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected ready server, got status code %d", response.StatusCode)
+	}
+
+	// the in-memory chain expires within 100 years, so readiness must fail
+	url = createServerWithOptions(t, issuer.Options{
+		ExpiryWarningWindow:          100 * 365 * 24 * time.Hour,
+		FailReadinessOnExpiryWarning: true,
+	})
+	// the warning is reported without waiting for a readiness probe
+	if got := testutil.ToFloat64(api.MetricCertificateExpiryWarning); got != 1 {
+		t.Fatalf("expected certificate expiry warning to be reported, got %v", got)
+	}
+
+	response, err = http.Get(url + "/ready")
+	if err != nil {
+		t.Fatalf("unexpected error getting readiness: %v", err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected unready server, got status code %d", response.StatusCode)
+	}
+	if !strings.Contains(string(body), "within the warning window") {
+		t.Fatalf("expected expiry warning in response, got %s", body)
+	}
+}
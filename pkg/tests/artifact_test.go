@@ -0,0 +1,92 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"testing"
+
+	ts "github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+)
+
+func TestGetTimestampResponseForArtifact(t *testing.T) {
+	url := createServerWithOptions(t, issuer.Options{}, api.WithArtifactHashing(1024))
+	artifact := bytes.Repeat([]byte("a"), 1024)
+
+	resp, err := http.Post(url+"/api/v1/timestamp/artifact?hashAlgorithm=sha384&certificates=true&tsaPolicyOID=1.2.3.4",
+		"application/octet-stream", bytes.NewReader(artifact))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", resp.StatusCode, body)
+	}
+	digest := sha512.Sum384(artifact)
+	if got := resp.Header.Get("X-Artifact-Digest"); got != "sha384:"+hex.EncodeToString(digest[:]) {
+		t.Fatalf("unexpected digest header %q", got)
+	}
+	tsr, err := ts.ParseResponse(body)
+	if err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+	if tsr.HashAlgorithm != crypto.SHA384 || !bytes.Equal(tsr.HashedMessage, digest[:]) {
+		t.Fatalf("expected timestamp over the digest of the artifact, got %v %x", tsr.HashAlgorithm, tsr.HashedMessage)
+	}
+	if !tsr.Policy.Equal(asn1.ObjectIdentifier{1, 2, 3, 4}) || len(tsr.Certificates) == 0 {
+		t.Fatalf("expected requested policy and certificates, got %v and %d certificates", tsr.Policy, len(tsr.Certificates))
+	}
+
+	for _, tc := range []struct {
+		name     string
+		url      string
+		artifact []byte
+		code     int
+	}{
+		{"too large", url + "/api/v1/timestamp/artifact", append(artifact, 'a'), http.StatusRequestEntityTooLarge},
+		{"invalid policy", url + "/api/v1/timestamp/artifact?tsaPolicyOID=1.a", artifact, http.StatusBadRequest},
+		{"weak hash", url + "/api/v1/timestamp/artifact?hashAlgorithm=sha1", artifact, http.StatusUnprocessableEntity},
+	} {
+		resp, err := http.Post(tc.url, "application/octet-stream", bytes.NewReader(tc.artifact))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tc.code {
+			t.Fatalf("%s: expected %d, got %d", tc.name, tc.code, resp.StatusCode)
+		}
+	}
+
+	// the API configuration is shared, so the disabled server must come last
+	resp, err = http.Post(createServer(t)+"/api/v1/timestamp/artifact", "application/octet-stream", bytes.NewReader(artifact))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected 501 when artifact hashing is disabled, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,211 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+// authenticodeRequestContent returns the signature countersigned by the
+// fixture request. The fixture is not a capture of signtool traffic: it was
+// built to the layout of the TimeStampRequest sent by signtool /t, around a
+// random 256 byte signature.
+func authenticodeRequestContent(t *testing.T, fixture []byte) []byte {
+	t.Helper()
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(fixture)), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req struct {
+		Type    asn1.ObjectIdentifier
+		Content struct {
+			ContentType asn1.ObjectIdentifier
+			Content     []byte `asn1:"explicit,tag:0"`
+		}
+	}
+	if _, err := asn1.Unmarshal(der, &req); err != nil {
+		t.Fatal(err)
+	}
+	return req.Content.Content
+}
+
+func TestGetAuthenticodeTimestampResponse(t *testing.T) {
+	url := createServer(t)
+
+	certChain := fetchCertChain(t, url)
+	roots := x509.NewCertPool()
+	roots.AddCert(certChain[len(certChain)-1])
+
+	fixture, err := os.ReadFile(filepath.Join("testdata", "authenticode-request.b64"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// some clients wrap the base64 encoded request in lines of 64 characters
+	var wrapped []byte
+	for rest := fixture; len(rest) > 0; {
+		n := min(64, len(rest))
+		wrapped = append(append(wrapped, rest[:n]...), "\r\n"...)
+		rest = rest[n:]
+	}
+
+	for name, fixture := range map[string][]byte{"request": fixture, "wrapped request": wrapped} {
+		before := time.Now().Add(-time.Second)
+		resp, err := http.Post(url+"/api/v1/timestamp/authenticode", "application/octet-stream", bytes.NewReader(fixture))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d: %s", name, resp.StatusCode, body)
+		}
+		if cc := resp.Header.Get("Cache-Control"); !strings.Contains(cc, "no-cache") {
+			t.Fatalf("%s: expected response not to be cached, got Cache-Control %q", name, cc)
+		}
+
+		der, err := base64.StdEncoding.DecodeString(string(body))
+		if err != nil {
+			t.Fatalf("%s: expected base64 encoded response: %v", name, err)
+		}
+		p7, err := pkcs7.Parse(der)
+		if err != nil {
+			t.Fatalf("%s: unexpected error parsing countersignature: %v", name, err)
+		}
+		if !bytes.Equal(p7.Content, authenticodeRequestContent(t, fixture)) {
+			t.Fatalf("%s: expected the requested signature to be countersigned", name)
+		}
+		if err := p7.VerifyWithChain(roots); err != nil {
+			t.Fatalf("%s: unexpected error verifying countersignature: %v", name, err)
+		}
+		if len(p7.Certificates) != len(certChain) {
+			t.Fatalf("%s: expected %d certificates, got %d", name, len(certChain), len(p7.Certificates))
+		}
+		var signingTime time.Time
+		if err := p7.UnmarshalSignedAttribute(pkcs7.OIDAttributeSigningTime, &signingTime); err != nil {
+			t.Fatalf("%s: unexpected error reading signing time: %v", name, err)
+		}
+		if signingTime.Before(before) || signingTime.After(time.Now()) {
+			t.Fatalf("%s: unexpected signing time %v", name, signingTime)
+		}
+	}
+
+	for name, body := range map[string]string{
+		"not base64":       "not a request",
+		"not a request":    base64.StdEncoding.EncodeToString([]byte("garbage")),
+		"rfc 3161 request": base64.StdEncoding.EncodeToString(buildTimestampQueryReq(t, []byte("blob"), timestamp.RequestOptions{Hash: crypto.SHA256})),
+	} {
+		resp, err := http.Post(url+"/api/v1/timestamp/authenticode", "application/octet-stream", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", name, resp.StatusCode)
+		}
+	}
+}
+
+// TestAuthenticodeSigntoolCapture replays a request captured from signtool
+// sign /t, and checks the response against the one returned by the timestamp
+// authority it was captured from. Capturing requires signtool on Windows, so
+// the test is skipped until the capture is committed to testdata as
+// signtool-request.b64 and signtool-response.b64, the base64 encoded bodies
+// as sent on the wire.
+func TestAuthenticodeSigntoolCapture(t *testing.T) {
+	request, err := os.ReadFile(filepath.Join("testdata", "signtool-request.b64"))
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skip("no signtool capture in testdata")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	captured, err := os.ReadFile(filepath.Join("testdata", "signtool-response.b64"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := createServer(t)
+
+	resp, err := http.Post(url+"/api/v1/timestamp/authenticode", "application/octet-stream", bytes.NewReader(request))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+
+	parse := func(b64 []byte) *pkcs7.PKCS7 {
+		t.Helper()
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(b64)), ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p7, err := pkcs7.Parse(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p7
+	}
+	got, want := parse(body), parse(captured)
+	if !bytes.Equal(got.Content, want.Content) || !bytes.Equal(got.Content, authenticodeRequestContent(t, request)) {
+		t.Fatal("expected the captured signature to be countersigned")
+	}
+	// the signed attributes are those of the captured countersignature
+	attributeTypes := func(p7 *pkcs7.PKCS7) []string {
+		var types []string
+		for _, attr := range p7.Signers[0].AuthenticatedAttributes {
+			types = append(types, attr.Type.String())
+		}
+		slices.Sort(types)
+		return types
+	}
+	if got, want := attributeTypes(got), attributeTypes(want); !slices.Equal(got, want) {
+		t.Fatalf("expected signed attributes %v, got %v", want, got)
+	}
+}
+
+func fetchCertChain(t *testing.T, url string) []*x509.Certificate {
+	t.Helper()
+	resp, err := http.Get(url + "/api/v1/timestamp/certchain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	pem, _ := io.ReadAll(resp.Body)
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certs
+}
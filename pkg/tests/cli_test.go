@@ -30,9 +30,11 @@ import (
 	"testing"
 
 	ts "github.com/digitorus/timestamp"
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/sigstore/timestamp-authority/pkg/client"
 	"github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -224,6 +226,26 @@ func TestVerify_InvalidPEM(t *testing.T) {
 	outputContains(t, out, "failed to parse intermediate and root certs from PEM file")
 }
 
+func TestTrustedRoot(t *testing.T) {
+	restapiURL := createServer(t)
+
+	out := runCli(t, "--timestamp_server", restapiURL, "trusted-root")
+	var tr prototrustroot.TrustedRoot
+	if err := protojson.Unmarshal([]byte(out), &tr); err != nil {
+		t.Fatalf("failed to parse CLI response as a trusted root: %v\n%s", err, out)
+	}
+	if len(tr.GetTimestampAuthorities()) != 1 {
+		t.Fatalf("expected one timestamp authority, got %d", len(tr.GetTimestampAuthorities()))
+	}
+
+	outPath := filepath.Join(t.TempDir(), "trusted_root.json")
+	out = runCli(t, "--timestamp_server", restapiURL, "trusted-root", "--out", outPath)
+	outputContains(t, out, "Wrote trusted root to")
+	if _, err := os.Stat(outPath); err != nil {
+		t.Errorf("expected trusted root file at path %s: %v", outPath, err)
+	}
+}
+
 func runCliErr(t *testing.T, arg ...string) string {
 	t.Helper()
 
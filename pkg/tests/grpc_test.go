@@ -0,0 +1,139 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"net"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/client"
+	"github.com/sigstore/timestamp-authority/pkg/generated/protobuf"
+	"github.com/sigstore/timestamp-authority/pkg/server"
+)
+
+// createGRPCClient serves the gRPC API of the server last created, and
+// returns a client of it.
+func createGRPCClient(t *testing.T) *client.GRPCTimestampClient {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := server.NewGRPCServer(nil)
+	go grpcServer.Serve(lis) //nolint:errcheck
+	t.Cleanup(grpcServer.Stop)
+
+	c, err := client.GetGRPCTimestampClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestGRPCTimestamp(t *testing.T) {
+	url := createServer(t)
+	c := createGRPCClient(t)
+	ctx := context.Background()
+
+	artifact := []byte("blob")
+	tsq := buildTimestampQueryReq(t, artifact, timestamp.RequestOptions{Hash: crypto.SHA256, Certificates: true})
+	resp, err := c.Timestamp(ctx, &protobuf.TimestampRequest{TimestampQuery: tsq})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	chain := fetchCertChain(t, url)
+	tsr, err := timestamp.ParseResponse(resp.GetTimestampReply())
+	if err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+	if len(tsr.Certificates) != 1 || !tsr.Certificates[0].Equal(chain[0]) {
+		t.Fatal("expected the leaf certificate of the REST API in the response")
+	}
+
+	_, err = c.Timestamp(ctx, &protobuf.TimestampRequest{TimestampQuery: []byte("invalid")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid request, got %v", err)
+	}
+
+	weak := buildTimestampQueryReq(t, artifact, timestamp.RequestOptions{Hash: crypto.SHA1})
+	batch, err := c.BatchTimestamp(ctx, &protobuf.BatchTimestampRequest{Requests: []*protobuf.TimestampRequest{
+		{TimestampQuery: tsq},
+		{TimestampQuery: weak},
+		{TimestampQuery: tsq},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results := batch.GetResults()
+	if len(results) != 3 || results[0].GetResponse() == nil || results[2].GetResponse() == nil {
+		t.Fatalf("expected the valid requests to be timestamped, got %v", results)
+	}
+	if e := results[1].GetError(); e == nil || codes.Code(e.GetCode()) != codes.InvalidArgument || e.GetMessage() != api.WeakHashAlgorithmTimestampRequest {
+		t.Fatalf("expected the weak request to be rejected, got %v", results[1])
+	}
+
+	for _, n := range []int{0, api.MaxBatchSize + 1} {
+		requests := make([]*protobuf.TimestampRequest, n)
+		for i := range requests {
+			requests[i] = &protobuf.TimestampRequest{TimestampQuery: tsq}
+		}
+		if _, err := c.BatchTimestamp(ctx, &protobuf.BatchTimestampRequest{Requests: requests}); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument for a batch of %d requests, got %v", n, err)
+		}
+	}
+}
+
+func TestGRPCGetCertChainAndInfo(t *testing.T) {
+	url := createServer(t)
+	c := createGRPCClient(t)
+	ctx := context.Background()
+
+	chain := fetchCertChain(t, url)
+	resp, err := c.GetCertChain(ctx, &protobuf.GetCertChainRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetCertificates()) != len(chain) {
+		t.Fatalf("expected %d certificates, got %d", len(chain), len(resp.GetCertificates()))
+	}
+	for i, der := range resp.GetCertificates() {
+		if !bytes.Equal(der, chain[i].Raw) {
+			t.Fatalf("certificate %d differs from the REST API", i)
+		}
+	}
+
+	info, err := c.GetInfo(ctx, &protobuf.GetInfoRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.GetDefaultPolicy() != "1.3.6.1.4.1.57264.2" || len(info.GetCertificates()) != len(chain) || info.GetAccuracy().AsDuration() <= 0 {
+		t.Fatalf("unexpected info %v", info)
+	}
+	if info.GetCertificates()[0].GetSubject() != chain[0].Subject.String() {
+		t.Fatalf("expected the leaf certificate first, got %v", info.GetCertificates()[0])
+	}
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+	"github.com/sigstore/timestamp-authority/pkg/verification"
+)
+
+// getLinks fetches entries of the link chain, returning the status code and
+// the chain.
+func getLinks(t *testing.T, url, query string) (int, *models.LinkChain) {
+	t.Helper()
+	resp, err := http.Get(url + "/api/v1/timestamp/links" + query)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+	var chain models.LinkChain
+	if err := json.Unmarshal(body, &chain); err != nil {
+		t.Fatalf("unexpected error parsing link chain %s: %v", body, err)
+	}
+	return resp.StatusCode, &chain
+}
+
+func TestLinkedTimestamps(t *testing.T) {
+	links, err := linking.Open(filepath.Join(t.TempDir(), "links.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer links.Close()
+	url := createServerWithOptions(t, issuer.Options{Linker: links}, api.WithLinks(links))
+
+	var timestamps []*timestamp.Timestamp
+	for i := 0; i < 3; i++ {
+		tsq := buildTimestampQueryReq(t, []byte(fmt.Sprintf("artifact %d", i)), timestamp.RequestOptions{Hash: crypto.SHA256})
+		resp, err := http.Post(url+"/api/v1/timestamp", "application/timestamp-query", bytes.NewReader(tsq))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		tsr, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, resp.StatusCode, tsr)
+		}
+		ts, err := timestamp.ParseResponse(tsr)
+		if err != nil {
+			t.Fatalf("unexpected error parsing response: %v", err)
+		}
+		timestamps = append(timestamps, ts)
+	}
+
+	code, chain := getLinks(t, url, "")
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	if *chain.Length != 3 || len(chain.Entries) != 3 || *chain.Entries[2].Head != *chain.Head {
+		t.Fatalf("unexpected link chain %+v", chain)
+	}
+	entries := make([]linking.Entry, 0, len(chain.Entries))
+	for _, e := range chain.Entries {
+		entries = append(entries, linking.Entry{Index: *e.Index, TokenHash: *e.TokenHash, Head: *e.Head})
+	}
+
+	// the first timestamp leads to the published head and to the link of the last one
+	published := linking.Entry{Index: *chain.Length - 1, Head: *chain.Head}
+	if err := verification.VerifyLinkage(timestamps[0], entries, published); err != nil {
+		t.Fatalf("unexpected error verifying against the published head: %v", err)
+	}
+	anchor, err := verification.LinkAnchor(timestamps[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verification.VerifyLinkage(timestamps[0], entries[:2], anchor); err != nil {
+		t.Fatalf("unexpected error verifying against a later timestamp: %v", err)
+	}
+
+	for _, tc := range []struct {
+		query   string
+		code    int
+		entries int
+	}{
+		{"?start=1", http.StatusOK, 2},
+		{"?start=1&end=2", http.StatusOK, 1},
+		{"?end=10", http.StatusOK, 3},
+		{"?start=3", http.StatusOK, 0},
+		{"?start=4", http.StatusBadRequest, 0},
+		{"?start=-1", http.StatusUnprocessableEntity, 0},
+	} {
+		code, chain := getLinks(t, url, tc.query)
+		if code != tc.code {
+			t.Fatalf("%s: expected status %d, got %d", tc.query, tc.code, code)
+		}
+		if chain != nil && len(chain.Entries) != tc.entries {
+			t.Fatalf("%s: expected %d entries, got %d", tc.query, tc.entries, len(chain.Entries))
+		}
+	}
+
+	// linking is disabled by default
+	if code, _ := getLinks(t, createServer(t), ""); code != http.StatusNotImplemented {
+		t.Fatalf("expected status %d, got %d", http.StatusNotImplemented, code)
+	}
+}
@@ -0,0 +1,116 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/client"
+	"github.com/sigstore/timestamp-authority/pkg/server"
+)
+
+// switchableOffset reports a fixed offset while synced.
+type switchableOffset struct {
+	offset time.Duration
+	synced atomic.Bool
+}
+
+func (o *switchableOffset) Offset() (time.Duration, bool) {
+	return o.offset, o.synced.Load()
+}
+
+// createRoughtimeServer starts a Roughtime responder on a random UDP port,
+// returning its address.
+func createRoughtimeServer(t *testing.T, opts server.RoughtimeOptions) string {
+	t.Helper()
+	s, err := server.NewRoughtimeServer(opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating roughtime server: %v", err)
+	}
+	conn, err := net.ListenPacket("udp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- s.Serve(conn) }()
+	t.Cleanup(func() {
+		conn.Close()
+		if err := <-done; err != nil {
+			t.Errorf("unexpected error serving roughtime: %v", err)
+		}
+	})
+	return conn.LocalAddr().String()
+}
+
+func TestRoughtime(t *testing.T) {
+	publicKey, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := &switchableOffset{offset: time.Hour}
+	addr := createRoughtimeServer(t, server.RoughtimeOptions{
+		Signer:             key,
+		Radius:             time.Second,
+		DelegationValidity: time.Hour,
+		ClockOffset:        offset,
+		CorrectTime:        true,
+		MaxClockCorrection: 10 * time.Second,
+	})
+
+	// requests are dropped until the local time is in sync
+	unsynced := testutil.ToFloat64(api.MetricRoughtimeRequests.WithLabelValues("unsynced"))
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetRoughtime(ctx, addr, publicKey); err == nil {
+		t.Fatal("expected no response while unsynced")
+	}
+	if got := testutil.ToFloat64(api.MetricRoughtimeRequests.WithLabelValues("unsynced")); got != unsynced+1 {
+		t.Fatalf("expected an unsynced request to be counted, got %v", got-unsynced)
+	}
+
+	offset.synced.Store(true)
+	before := time.Now()
+	rt, err := client.GetRoughtime(context.Background(), addr, publicKey)
+	if err != nil {
+		t.Fatalf("unexpected error getting roughtime: %v", err)
+	}
+	// the time is corrected by the capped offset
+	corrected := before.Add(10 * time.Second)
+	if rt.Radius != time.Second || rt.Earliest().After(corrected) || rt.Latest().Before(corrected) {
+		t.Fatalf("expected %v to be within %+v", corrected, rt)
+	}
+
+	if _, err := server.NewRoughtimeServer(server.RoughtimeOptions{Signer: key, Radius: time.Second, DelegationValidity: time.Hour, MaxClockCorrection: -time.Second}); err == nil {
+		t.Fatal("expected error for a negative maximum clock correction")
+	}
+
+	// a response is only accepted from the server with the public key
+	otherKey, _, _ := ed25519.GenerateKey(rand.Reader)
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetRoughtime(ctx, addr, otherKey); err == nil {
+		t.Fatal("expected error for another public key")
+	}
+}
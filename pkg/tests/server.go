@@ -16,21 +16,29 @@
 package tests
 
 import (
+	"context"
+	"crypto"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
-	"github.com/spf13/viper"
-
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
 	"github.com/sigstore/timestamp-authority/pkg/server"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
 )
 
 func createServer(t *testing.T) string {
-	viper.Set("timestamp-signer", "memory")
-	viper.Set("timestamp-signer-hash", "sha256")
+	return createServerWithOptions(t, issuer.Options{})
+}
+
+// createServerWithOptions starts a server backed by an in-memory signer and
+// certificate chain, with any other issuer options taken from opts.
+func createServerWithOptions(t *testing.T, opts issuer.Options, apiOpts ...api.Option) string {
+	tsaIssuer := newMemoryIssuer(t, opts)
 	// unused port
-	apiServer := server.NewRestAPIServer("localhost", 0, []string{"http"}, false, 10*time.Second, 10*time.Second)
+	apiServer := server.NewRestAPIServer("localhost", 0, []string{"http"}, false, 10*time.Second, 10*time.Second, tsaIssuer, apiOpts...)
 	server := httptest.NewServer(apiServer.GetHandler())
 	t.Cleanup(server.Close)
 
@@ -42,3 +50,22 @@ func createServer(t *testing.T) string {
 
 	return server.URL
 }
+
+func newMemoryIssuer(t *testing.T, opts issuer.Options) *issuer.Issuer {
+	tsaSigner, err := signer.NewCryptoSigner(context.Background(), crypto.SHA256, signer.MemoryScheme, "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error creating signer: %v", err)
+	}
+	certChain, err := signer.NewTimestampingCertWithChain(tsaSigner)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate chain: %v", err)
+	}
+	opts.Signer = tsaSigner
+	opts.SignerHash = crypto.SHA256
+	opts.CertChain = certChain
+	tsaIssuer, err := issuer.New(opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	return tsaIssuer
+}
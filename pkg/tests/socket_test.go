@@ -0,0 +1,120 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ts "github.com/digitorus/timestamp"
+	"github.com/go-openapi/runtime"
+
+	"github.com/sigstore/timestamp-authority/pkg/client"
+	"github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/server"
+)
+
+func TestUnixSocket(t *testing.T) {
+	// t.TempDir may exceed the maximum length of a socket path
+	dir, err := os.MkdirTemp("", "tsa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "tsa.sock")
+
+	// leave a stale socket behind, as a server that crashed would
+	stale, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	apiServer := server.NewRestAPIServer("localhost", 0, []string{"unix"}, false, 10*time.Second, 10*time.Second, newMemoryIssuer(t, issuer.Options{}))
+	if err := server.Listen(apiServer, server.SocketOptions{Path: path, Mode: 0660}); err != nil {
+		t.Fatalf("unexpected error listening on socket: %v", err)
+	}
+	l, err := apiServer.UnixListener()
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpServer := &http.Server{Handler: apiServer.GetHandler(), ReadHeaderTimeout: 10 * time.Second}
+	go httpServer.Serve(l) //nolint:errcheck
+	t.Cleanup(func() { httpServer.Close() })
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode() != os.ModeSocket|0660 {
+		t.Fatalf("expected socket mode 0660, got %v", fi.Mode())
+	}
+
+	// a socket in use is not replaced
+	other := server.NewRestAPIServer("localhost", 0, []string{"unix"}, false, 10*time.Second, 10*time.Second, newMemoryIssuer(t, issuer.Options{}))
+	if err := server.Listen(other, server.SocketOptions{Path: path, Mode: 0660}); err == nil {
+		t.Fatal("expected error listening on a socket in use")
+	}
+
+	// the full middleware chain serves requests over the socket
+	httpClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		},
+	}}
+	for path, want := range map[string]int{"/ping": http.StatusOK, "/ready": http.StatusOK, "/api/v1/timestamp/info": http.StatusOK} {
+		resp, err := httpClient.Get("http://localhost" + path)
+		if err != nil {
+			t.Fatalf("unexpected error getting %s: %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Fatalf("expected status code %d for %s, got %d", want, path, resp.StatusCode)
+		}
+	}
+
+	c, err := client.GetTimestampClient("http://localhost", client.WithUnixSocket(path), client.WithContentType(client.JSONMediaType))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	if _, err := c.Timestamp.GetTimestampCertChain(nil); err != nil {
+		t.Fatalf("unexpected error getting certificate chain: %v", err)
+	}
+	params := timestamp.NewGetTimestampResponseParams()
+	params.SetTimeout(10 * time.Second)
+	params.Request = io.NopCloser(bytes.NewReader(buildJSONReq(t, []byte("blob"), crypto.SHA256, "sha256", true, nil, "")))
+	var respBytes bytes.Buffer
+	_, err = c.Timestamp.GetTimestampResponse(params, &respBytes, func(op *runtime.ClientOperation) {
+		op.ConsumesMediaTypes = []string{client.JSONMediaType}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error getting timestamp over the socket: %v", err)
+	}
+	if _, err := ts.ParseResponse(respBytes.Bytes()); err != nil {
+		t.Fatalf("unexpected error parsing response: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+
+	"github.com/sigstore/timestamp-authority/pkg/api"
+	"github.com/sigstore/timestamp-authority/pkg/generated/models"
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/trustedroot"
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+// postVerify posts the timestamp response and form fields to the verify
+// endpoint, and returns the status code and verification report.
+func postVerify(t *testing.T, url string, tsr []byte, artifact []byte, fields map[string]string) (int, *models.VerificationReport) {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	files := map[string][]byte{"tsr": tsr}
+	if artifact != nil {
+		files["artifact"] = artifact
+	}
+	for name, content := range files {
+		fw, err := w.CreateFormFile(name, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, value := range fields {
+		if err := w.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Post(url+"/api/v1/timestamp/verify", w.FormDataContentType(), &body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+	var report models.VerificationReport
+	if err := json.Unmarshal(respBody, &report); err != nil {
+		t.Fatalf("unexpected error parsing report %s: %v", respBody, err)
+	}
+	return resp.StatusCode, &report
+}
+
+func failedChecks(report *models.VerificationReport) []string {
+	var failed []string
+	for _, c := range report.Checks {
+		if !*c.Passed {
+			failed = append(failed, *c.Name)
+		}
+	}
+	return failed
+}
+
+func TestVerifyTimestampResponse(t *testing.T) {
+	url := createServerWithOptions(t, issuer.Options{}, api.WithArtifactHashing(1024))
+	artifact := []byte("artifact")
+	resp, err := http.Post(url+"/api/v1/timestamp/artifact?certificates=true", "application/octet-stream", bytes.NewReader(artifact))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tsr, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	digest := sha256.Sum256(artifact)
+	digestField := map[string]string{"digest": "sha256:" + hex.EncodeToString(digest[:])}
+
+	code, report := postVerify(t, url, tsr, nil, digestField)
+	if code != http.StatusOK || !*report.Verified || report.TrustedChain != models.VerificationReportTrustedChainCurrent {
+		t.Fatalf("expected the timestamp to verify against the current chain, got %d %+v", code, report)
+	}
+	if len(report.Checks) == 0 || len(failedChecks(report)) != 0 {
+		t.Fatalf("expected passing checks, got %v failed", failedChecks(report))
+	}
+	if *report.Timestamp.HashAlgorithm != "sha256" || *report.Timestamp.HashedMessage != hex.EncodeToString(digest[:]) ||
+		len(report.Timestamp.Certificates) != 1 {
+		t.Fatalf("unexpected decoded timestamp %+v", report.Timestamp)
+	}
+
+	// base64 encoded response over the uploaded artifact
+	code, report = postVerify(t, url, []byte(base64.StdEncoding.EncodeToString(tsr)), artifact, nil)
+	if code != http.StatusOK || !*report.Verified {
+		t.Fatalf("expected the base64 encoded timestamp to verify against the artifact, got %d %+v", code, report)
+	}
+
+	code, report = postVerify(t, url, tsr, []byte("other artifact"), nil)
+	if failed := failedChecks(report); code != http.StatusOK || *report.Verified || len(failed) != 1 || failed[0] != "messageImprint" {
+		t.Fatalf("expected the message imprint check to fail, got %d %v", code, failed)
+	}
+
+	// a response without certificates is parsed without verifying its signature
+	resp, err = http.Post(url+"/api/v1/timestamp/artifact", "application/octet-stream", bytes.NewReader(artifact))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certless, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	unknownHash, err := testutils.WithUnknownImprintAlgorithm(certless)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		tsr      []byte
+		artifact []byte
+		fields   map[string]string
+		code     int
+	}{
+		"no digest":      {tsr: tsr, code: http.StatusBadRequest},
+		"both":           {tsr: tsr, artifact: artifact, fields: digestField, code: http.StatusBadRequest},
+		"invalid digest": {tsr: tsr, fields: map[string]string{"digest": "sha256:abcd"}, code: http.StatusBadRequest},
+		"invalid tsr":    {tsr: []byte("garbage"), fields: digestField, code: http.StatusBadRequest},
+		"unknown hash":   {tsr: unknownHash, artifact: artifact, code: http.StatusBadRequest},
+		"invalid chain":  {tsr: tsr, fields: map[string]string{"digest": digestField["digest"], "certificateChain": "garbage"}, code: http.StatusBadRequest},
+	} {
+		if code, _ := postVerify(t, url, tc.tsr, tc.artifact, tc.fields); code != tc.code {
+			t.Fatalf("%s: expected %d, got %d", name, tc.code, code)
+		}
+	}
+
+	// after a rotation, the previous chain is still trusted
+	chain := fetchCertChain(t, url)
+	url = createServerWithOptions(t, issuer.Options{}, api.WithHistoricalChains([]trustedroot.Chain{{Certificates: chain}}))
+	code, report = postVerify(t, url, tsr, nil, digestField)
+	if code != http.StatusOK || !*report.Verified || report.TrustedChain != models.VerificationReportTrustedChainHistorical {
+		t.Fatalf("expected the timestamp to verify against the historical chain, got %d %+v", code, report)
+	}
+
+	// chains of other timestamp authorities are only trusted when supplied
+	url = createServer(t)
+	code, report = postVerify(t, url, tsr, nil, digestField)
+	if code != http.StatusOK || *report.Verified || report.TrustedChain != models.VerificationReportTrustedChainCurrent {
+		t.Fatalf("expected the timestamp not to verify against another chain, got %d %+v", code, report)
+	}
+	pemChain, err := cryptoutils.MarshalCertificatesToPEM(chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, report = postVerify(t, url, tsr, nil, map[string]string{"digest": digestField["digest"], "certificateChain": string(pemChain)})
+	if code != http.StatusOK || !*report.Verified || report.TrustedChain != models.VerificationReportTrustedChainSupplied {
+		t.Fatalf("expected the timestamp to verify against the supplied chain, got %d %+v", code, report)
+	}
+
+	// the API configuration is shared, so the disabled server must come last
+	if code, _ := postVerify(t, url, tsr, artifact, nil); code != http.StatusNotImplemented {
+		t.Fatalf("expected 501 for an artifact when artifact hashing is disabled, got %d", code)
+	}
+}
@@ -0,0 +1,129 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/sigstore/timestamp-authority/pkg/issuer"
+	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/tracing"
+)
+
+func TestMiddlewareSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	tsaSigner, err := signer.NewCryptoSigner(context.Background(), crypto.SHA256, signer.MemoryScheme, "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error creating signer: %v", err)
+	}
+	certChain, err := signer.NewTimestampingCertWithChain(tsaSigner)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate chain: %v", err)
+	}
+	i, err := issuer.New(issuer.Options{Signer: tsaSigner, CertChain: certChain, SignerBackend: "memory"})
+	if err != nil {
+		t.Fatalf("unexpected error creating issuer: %v", err)
+	}
+	server := httptest.NewServer(tracing.Middleware(issuer.NewHandler(i)))
+	t.Cleanup(server.Close)
+
+	digest := sha256.Sum256([]byte("artifact"))
+	tsq, err := (&timestamp.Request{HashAlgorithm: crypto.SHA256, HashedMessage: digest[:]}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader(tsq))
+	req.Header.Set("Content-Type", "application/timestamp-query")
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	resp.Body.Close()
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, s := range exporter.GetSpans() {
+		if s.SpanContext.TraceID().String() != traceID {
+			t.Fatalf("span %s is not part of the propagated trace", s.Name)
+		}
+		spans[s.Name] = s
+	}
+	for _, name := range []string{"POST /", "timestamp.parse_request", "timestamp.issue", "timestamp.check_request", "timestamp.encode_response", "timestamp.sign"} {
+		if _, ok := spans[name]; !ok {
+			t.Fatalf("expected span %s, got %v", name, exporter.GetSpans())
+		}
+	}
+	if parent := spans["timestamp.sign"].Parent.SpanID(); parent != spans["timestamp.encode_response"].SpanContext.SpanID() {
+		t.Fatal("expected signing span to be a child of the response encoding span")
+	}
+	var backend string
+	for _, attr := range spans["timestamp.sign"].Attributes {
+		if attr.Key == "tsa.signer.backend" {
+			backend = attr.Value.AsString()
+		}
+	}
+	if backend != "memory" {
+		t.Fatalf("expected signer backend attribute, got %q", backend)
+	}
+}
+
+func TestSetupFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{Exporter: tracing.ExporterFile, Path: path, SampleRatio: 1})
+	if err != nil {
+		t.Fatalf("unexpected error setting up tracing: %v", err)
+	}
+	_, span := tracing.Tracer().Start(context.Background(), "test")
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error shutting down tracing: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"Name":"test"`) {
+		t.Fatalf("expected span in %s, got %s", path, data)
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	if _, err := tracing.Setup(context.Background(), tracing.Options{Exporter: "zipkin"}); err == nil {
+		t.Fatal("expected error for unsupported exporter")
+	}
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trustedroot describes a timestamp authority as the
+// timestampAuthorities entries of a Sigstore TrustedRoot.
+package trustedroot
+
+import (
+	"crypto/x509"
+	"errors"
+	"time"
+
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Chain is a certificate chain used by the timestamp authority during a
+// period of time.
+type Chain struct {
+	// Certificates starting with the leaf certificate and ending with the root.
+	Certificates []*x509.Certificate
+	// Start of the period the chain was used. Defaults to the time all
+	// certificates in the chain became valid.
+	Start time.Time
+	// End of the period the chain was used. The chain is in use when zero.
+	End time.Time
+}
+
+// validFor returns the period the chain was used.
+func (c Chain) validFor() *protocommon.TimeRange {
+	start := c.Start
+	if start.IsZero() {
+		for _, cert := range c.Certificates {
+			if cert.NotBefore.After(start) {
+				start = cert.NotBefore
+			}
+		}
+	}
+	tr := &protocommon.TimeRange{Start: timestamppb.New(start)}
+	if !c.End.IsZero() {
+		tr.End = timestamppb.New(c.End)
+	}
+	return tr
+}
+
+// NotAfter returns the time the first certificate in the chain expires.
+func NotAfter(certs []*x509.Certificate) time.Time {
+	var notAfter time.Time
+	for _, cert := range certs {
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	return notAfter
+}
+
+// New returns a TrustedRoot holding only a timestampAuthorities entry for
+// each chain, identified by uri.
+func New(uri string, chains []Chain) (*prototrustroot.TrustedRoot, error) {
+	tr := &prototrustroot.TrustedRoot{}
+	for _, c := range chains {
+		if len(c.Certificates) == 0 {
+			return nil, errors.New("certificate chain must not be empty")
+		}
+		root := c.Certificates[len(c.Certificates)-1]
+		subject := &protocommon.DistinguishedName{CommonName: root.Subject.CommonName}
+		if len(root.Subject.Organization) > 0 {
+			subject.Organization = root.Subject.Organization[0]
+		}
+
+		certChain := &protocommon.X509CertificateChain{}
+		for _, cert := range c.Certificates {
+			certChain.Certificates = append(certChain.Certificates, &protocommon.X509Certificate{RawBytes: cert.Raw})
+		}
+
+		tr.TimestampAuthorities = append(tr.TimestampAuthorities, &prototrustroot.CertificateAuthority{
+			Subject:   subject,
+			Uri:       uri,
+			CertChain: certChain,
+			ValidFor:  c.validFor(),
+		})
+	}
+	return tr, nil
+}
+
+// Marshal encodes the timestampAuthorities entries for the chains as JSON,
+// in the format of a Sigstore trusted_root.json.
+func Marshal(uri string, chains []Chain) ([]byte, error) {
+	tr, err := New(uri, chains)
+	if err != nil {
+		return nil, err
+	}
+	return protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(tr)
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedroot
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	prototrustroot "github.com/sigstore/protobuf-specs/gen/pb-go/trustroot/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
+)
+
+func newChain(t *testing.T) []*x509.Certificate {
+	t.Helper()
+	rootCert, rootKey, err := testutils.GenerateRootCa()
+	if err != nil {
+		t.Fatalf("unexpected error generating root: %v", err)
+	}
+	subCert, subKey, err := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	if err != nil {
+		t.Fatalf("unexpected error generating intermediate: %v", err)
+	}
+	leafCert, _, err := testutils.GenerateLeafCert(subCert, subKey)
+	if err != nil {
+		t.Fatalf("unexpected error generating leaf: %v", err)
+	}
+	return []*x509.Certificate{leafCert, subCert, rootCert}
+}
+
+func TestMarshal(t *testing.T) {
+	oldChain := newChain(t)
+	currentChain := newChain(t)
+	rotation := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	data, err := Marshal("https://tsa.example.com/api/v1/timestamp", []Chain{
+		{Certificates: oldChain, End: rotation},
+		{Certificates: currentChain},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error marshalling trusted root: %v", err)
+	}
+
+	var tr prototrustroot.TrustedRoot
+	if err := protojson.Unmarshal(data, &tr); err != nil {
+		t.Fatalf("unexpected error unmarshalling trusted root: %v", err)
+	}
+	tsas := tr.GetTimestampAuthorities()
+	if len(tsas) != 2 {
+		t.Fatalf("expected 2 timestamp authorities, got %d", len(tsas))
+	}
+
+	for i, chain := range [][]*x509.Certificate{oldChain, currentChain} {
+		tsa := tsas[i]
+		if tsa.GetUri() != "https://tsa.example.com/api/v1/timestamp" {
+			t.Fatalf("unexpected URI %s", tsa.GetUri())
+		}
+		if tsa.GetSubject().GetCommonName() != "Test TSA Timestamping Root" || tsa.GetSubject().GetOrganization() != "local" {
+			t.Fatalf("unexpected subject %v", tsa.GetSubject())
+		}
+		certs := tsa.GetCertChain().GetCertificates()
+		if len(certs) != len(chain) {
+			t.Fatalf("expected %d certificates, got %d", len(chain), len(certs))
+		}
+		for j := range chain {
+			if !bytes.Equal(certs[j].GetRawBytes(), chain[j].Raw) {
+				t.Fatalf("unexpected certificate %d in chain %d", j, i)
+			}
+		}
+		// the leaf is the last certificate to become valid
+		if start := tsa.GetValidFor().GetStart().AsTime(); !start.Equal(chain[0].NotBefore) {
+			t.Fatalf("expected chain %d to start at %v, got %v", i, chain[0].NotBefore, start)
+		}
+	}
+
+	if end := tsas[0].GetValidFor().GetEnd().AsTime(); !end.Equal(rotation) {
+		t.Fatalf("expected historical chain to end at %v, got %v", rotation, end)
+	}
+	if tsas[1].GetValidFor().End != nil {
+		t.Fatalf("expected current chain to have no end, got %v", tsas[1].GetValidFor().GetEnd().AsTime())
+	}
+}
+
+func TestNewEmptyChain(t *testing.T) {
+	if _, err := New("", []Chain{{}}); err == nil {
+		t.Fatal("expected error for empty chain")
+	}
+}
+
+func TestNotAfter(t *testing.T) {
+	chain := newChain(t)
+	// the leaf expires first
+	if got := NotAfter(chain); !got.Equal(chain[0].NotAfter) {
+		t.Fatalf("expected %v, got %v", chain[0].NotAfter, got)
+	}
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+)
+
+// LinkAnchor returns the head of the link chain before a linked timestamp, to
+// be used as the anchor of earlier timestamps.
+func LinkAnchor(ts *timestamp.Timestamp) (linking.Entry, error) {
+	link, err := linking.FromExtensions(ts.Extensions)
+	if err != nil {
+		return linking.Entry{}, err
+	}
+	if link.Index == 0 {
+		return linking.Entry{}, fmt.Errorf("the first timestamp of the chain has no previous head")
+	}
+	return linking.Entry{Index: link.Index - 1, Head: hex.EncodeToString(link.Previous)}, nil
+}
+
+// VerifyLinkage verifies that a linked timestamp is part of the link chain
+// ending at anchor, a later head of the chain obtained independently of the
+// timestamp authority, such as a published head or the LinkAnchor of a later
+// timestamp. entries are the published entries of the chain from the
+// timestamp up to the anchor. The heads of the chain are recomputed from the
+// link of the timestamp, so only the token hashes of entries are trusted, and
+// the timestamp cannot have been added to the chain after the anchor.
+func VerifyLinkage(ts *timestamp.Timestamp, entries []linking.Entry, anchor linking.Entry) error {
+	link, err := linking.FromExtensions(ts.Extensions)
+	if err != nil {
+		return err
+	}
+	if anchor.Index < link.Index {
+		return fmt.Errorf("anchor at index %d precedes the timestamp at index %d", anchor.Index, link.Index)
+	}
+	if int64(len(entries)) != anchor.Index-link.Index+1 {
+		return fmt.Errorf("expected %d entries from index %d to the anchor at index %d, got %d",
+			anchor.Index-link.Index+1, link.Index, anchor.Index, len(entries))
+	}
+
+	head := link.Previous
+	for i, e := range entries {
+		index := link.Index + int64(i)
+		if e.Index != index {
+			return fmt.Errorf("expected entry %d, got entry %d", index, e.Index)
+		}
+		tokenHash, err := hex.DecodeString(e.TokenHash)
+		if err != nil {
+			return fmt.Errorf("invalid token hash of entry %d: %w", index, err)
+		}
+		if i == 0 && !bytes.Equal(tokenHash, linking.TokenHash(ts.RawToken)) {
+			return fmt.Errorf("entry %d is not the timestamp", index)
+		}
+		head = linking.NextHead(head, tokenHash)
+	}
+
+	anchorHead, err := hex.DecodeString(anchor.Head)
+	if err != nil {
+		return fmt.Errorf("invalid anchor head: %w", err)
+	}
+	if !bytes.Equal(head, anchorHead) {
+		return fmt.Errorf("the chain from the timestamp does not lead to the anchor at index %d", anchor.Index)
+	}
+	return nil
+}
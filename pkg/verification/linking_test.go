@@ -0,0 +1,99 @@
+//
+// Copyright 2026 The Sigstore Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import (
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/sigstore/timestamp-authority/pkg/linking"
+)
+
+func TestVerifyLinkage(t *testing.T) {
+	c, err := linking.Open(filepath.Join(t.TempDir(), "links.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var timestamps []*timestamp.Timestamp
+	for i := 0; i < 4; i++ {
+		err := c.Link(func(ext pkix.Extension) ([]byte, error) {
+			ts := &timestamp.Timestamp{RawToken: []byte(fmt.Sprintf("token %d", i)), Extensions: []pkix.Extension{ext}}
+			timestamps = append(timestamps, ts)
+			return ts.RawToken, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	length, head := c.Head()
+	published := linking.Entry{Index: length - 1, Head: hex.EncodeToString(head)}
+	later, err := LinkAnchor(timestamps[3])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := func(start, end int64) []linking.Entry {
+		e, err := c.Entries(start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	tampered := entries(1, 4)
+	tampered[1].TokenHash = hex.EncodeToString(linking.TokenHash([]byte("other")))
+	forged := entries(1, 4)
+	forged[0].TokenHash = hex.EncodeToString(linking.TokenHash([]byte("token 0")))
+
+	for _, tc := range []struct {
+		name    string
+		ts      *timestamp.Timestamp
+		entries []linking.Entry
+		anchor  linking.Entry
+		err     string
+	}{
+		{"published head", timestamps[1], entries(1, 4), published, ""},
+		{"later timestamp", timestamps[0], entries(0, 3), later, ""},
+		{"anchor is the timestamp", timestamps[3], entries(3, 4), published, ""},
+		{"missing entries", timestamps[1], entries(1, 3), published, "expected 3 entries"},
+		{"wrong entries", timestamps[1], entries(0, 3), published, "expected entry 1, got entry 0"},
+		{"other timestamp", timestamps[1], forged, published, "entry 1 is not the timestamp"},
+		{"tampered entry", timestamps[1], tampered, published, "does not lead to the anchor"},
+		{"anchor before timestamp", timestamps[3], nil, later, "precedes the timestamp"},
+		{"not linked", &timestamp.Timestamp{}, nil, published, linking.ErrNotLinked.Error()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyLinkage(tc.ts, tc.entries, tc.anchor)
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+
+	if _, err := LinkAnchor(timestamps[0]); err == nil || errors.Is(err, linking.ErrNotLinked) {
+		t.Fatalf("expected error for the first timestamp, got %v", err)
+	}
+}
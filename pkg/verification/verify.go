@@ -17,6 +17,7 @@ package verification
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/x509"
 	"encoding/asn1"
 	"fmt"
@@ -27,6 +28,8 @@ import (
 	"github.com/digitorus/pkcs7"
 	"github.com/digitorus/timestamp"
 	"github.com/pkg/errors"
+
+	tsx509 "github.com/sigstore/timestamp-authority/pkg/x509"
 )
 
 var (
@@ -50,6 +53,40 @@ type VerifyOpts struct {
 	Nonce *big.Int
 	// CommonName verifies that the TSR certificate subject's Common Name matches the expected value. Optional
 	CommonName string
+	// RequireCertificatePolicy verifies that the TSR's policy is listed in the certificatePolicies
+	// extension of the TSA certificate, which authorizes the TSA to issue timestamps under the policy. Optional
+	RequireCertificatePolicy bool
+}
+
+// Names of the checks made when verifying a timestamp response.
+const (
+	CheckSignature         = "signature"
+	CheckNonce             = "nonce"
+	CheckPolicy            = "policy"
+	CheckTSACertificate    = "tsaCertificate"
+	CheckCriticalEKU       = "criticalEKU"
+	CheckESSCertID         = "essCertID"
+	CheckCommonName        = "commonName"
+	CheckCertificatePolicy = "certificatePolicy"
+	CheckTimestampingEKU   = "timestampingEKU"
+	CheckMessageImprint    = "messageImprint"
+)
+
+// Check is the outcome of one of the checks made when verifying a timestamp
+// response.
+type Check struct {
+	// Name is one of the Check constants.
+	Name string
+	// Err is nil when the check passed.
+	Err error
+}
+
+// Imprint is what a timestamp response is expected to cover: either the
+// artifact, or its digest computed with Hash.
+type Imprint struct {
+	Artifact io.Reader
+	Hash     crypto.Hash
+	Digest   []byte
 }
 
 // Verify the TSR's certificate identifier matches a provided TSA certificate
@@ -81,6 +118,17 @@ func verifySubjectCommonName(cert *x509.Certificate, opts VerifyOpts) error {
 	return nil
 }
 
+// Verify the leaf certificate authorizes the TSR's policy
+func verifyCertificatePolicy(cert *x509.Certificate, policy asn1.ObjectIdentifier, opts VerifyOpts) error {
+	if !opts.RequireCertificatePolicy {
+		return nil
+	}
+	if err := tsx509.VerifyCertificatePolicy(cert, policy); err != nil {
+		return fmt.Errorf("TSR policy %s is not authorized by the TSA certificate: %w", policy, err)
+	}
+	return nil
+}
+
 // If embedded in the TSR, verify the TSR's leaf certificate matches a provided TSA certificate
 func verifyEmbeddedLeafCert(tsaCert *x509.Certificate, opts VerifyOpts) error {
 	if opts.TSACertificate != nil && !opts.TSACertificate.Equal(tsaCert) {
@@ -105,47 +153,51 @@ func verifyLeafCertCriticalEKU(cert *x509.Certificate) error {
 }
 
 func verifyLeafCert(ts timestamp.Timestamp, opts VerifyOpts) error {
+	return firstFailure(leafCertChecks(ts, opts))
+}
+
+// leafCertChecks runs each check of the TSA certificate, which is the
+// certificate embedded in the TSR or the one provided in opts.
+func leafCertChecks(ts timestamp.Timestamp, opts VerifyOpts) []Check {
 	if len(ts.Certificates) == 0 && opts.TSACertificate == nil {
-		return fmt.Errorf("leaf certificate must be present the in TSR or as a verify option")
+		return []Check{{Name: CheckTSACertificate, Err: fmt.Errorf("leaf certificate must be present the in TSR or as a verify option")}}
 	}
 
 	errMsg := "failed to verify TSA certificate"
+	wrap := func(err error) error {
+		if err != nil {
+			return fmt.Errorf("%s: %w", errMsg, err)
+		}
+		return nil
+	}
 
+	var checks []Check
 	var leafCert *x509.Certificate
 	if len(ts.Certificates) != 0 {
 		leafCert = ts.Certificates[0]
-
-		err := verifyEmbeddedLeafCert(leafCert, opts)
-		if err != nil {
-			return fmt.Errorf("%s: %w", errMsg, err)
-		}
+		checks = append(checks, Check{Name: CheckTSACertificate, Err: wrap(verifyEmbeddedLeafCert(leafCert, opts))})
 	} else {
 		leafCert = opts.TSACertificate
 	}
 
-	err := verifyLeafCertCriticalEKU(leafCert)
-	if err != nil {
-		return fmt.Errorf("%s: %w", errMsg, err)
+	checks = append(checks,
+		Check{Name: CheckCriticalEKU, Err: wrap(verifyLeafCertCriticalEKU(leafCert))},
+		Check{Name: CheckESSCertID, Err: wrap(verifyESSCertID(leafCert, opts))},
+	)
+	if opts.CommonName != "" {
+		checks = append(checks, Check{Name: CheckCommonName, Err: wrap(verifySubjectCommonName(leafCert, opts))})
 	}
-
-	err = verifyESSCertID(leafCert, opts)
-	if err != nil {
-		return fmt.Errorf("%s: %w", errMsg, err)
-	}
-
-	err = verifySubjectCommonName(leafCert, opts)
-	if err != nil {
-		return fmt.Errorf("%s: %w", errMsg, err)
+	if opts.RequireCertificatePolicy {
+		checks = append(checks, Check{Name: CheckCertificatePolicy, Err: wrap(verifyCertificatePolicy(leafCert, ts.Policy, opts))})
 	}
 
 	// verifies that the leaf certificate and any intermediate certificates
 	// have EKU set to only time stamping usage
-	err = verifyLeafAndIntermediatesTimestampingEKU(leafCert, opts)
-	if err != nil {
-		return fmt.Errorf("failed to verify EKU on leaf certificate: %w", err)
+	var err error
+	if err = verifyLeafAndIntermediatesTimestampingEKU(leafCert, opts); err != nil {
+		err = fmt.Errorf("failed to verify EKU on leaf certificate: %w", err)
 	}
-
-	return nil
+	return append(checks, Check{Name: CheckTimestampingEKU, Err: err})
 }
 
 func verifyExtendedKeyUsage(cert *x509.Certificate) error {
@@ -207,41 +259,99 @@ func verifyNonce(requestNonce *big.Int, opts VerifyOpts) error {
 
 // VerifyTimestampResponse the timestamp response using a timestamp certificate chain.
 func VerifyTimestampResponse(tsrBytes []byte, artifact io.Reader, opts VerifyOpts) (*timestamp.Timestamp, error) {
+	ts, checks, err := CheckTimestampResponse(tsrBytes, Imprint{Artifact: artifact}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := firstFailure(checks); err != nil {
+		return nil, err
+	}
+
+	// if the parsed timestamp is verified, return the timestamp
+	return ts, nil
+}
+
+// CheckTimestampResponse parses a timestamp response and runs every check of
+// VerifyTimestampResponse, rather than stopping at the first failure. Checks
+// opts does not ask for, such as the nonce, are left out. An error is only
+// returned if the response cannot be parsed.
+func CheckTimestampResponse(tsrBytes []byte, imprint Imprint, opts VerifyOpts) (*timestamp.Timestamp, []Check, error) {
 	// Verify the status of the TSR does not contain an error
 	// handled by the timestamp.ParseResponse function
 	ts, err := timestamp.ParseResponse(tsrBytes)
 	if err != nil {
 		pe := timestamp.ParseError("")
 		if errors.As(err, &pe) {
-			return nil, fmt.Errorf("timestamp response is not valid: %w", err)
+			return nil, nil, fmt.Errorf("timestamp response is not valid: %w", err)
 		}
-		return nil, fmt.Errorf("error parsing response into Timestamp: %w", err)
+		return nil, nil, fmt.Errorf("error parsing response into Timestamp: %w", err)
 	}
 
 	// verify the timestamp response signature using the provided certificate pool
-	if err = verifyTSRWithChain(ts, opts); err != nil {
-		return nil, err
+	checks := []Check{{Name: CheckSignature, Err: verifyTSRWithChain(ts, opts)}}
+
+	if opts.Nonce != nil {
+		checks = append(checks, Check{Name: CheckNonce, Err: verifyNonce(ts.Nonce, opts)})
 	}
 
-	if err = verifyNonce(ts.Nonce, opts); err != nil {
-		return nil, err
+	if opts.OID != nil {
+		checks = append(checks, Check{Name: CheckPolicy, Err: verifyOID(ts.Policy, opts)})
 	}
 
-	if err = verifyOID(ts.Policy, opts); err != nil {
-		return nil, err
+	checks = append(checks, leafCertChecks(*ts, opts)...)
+
+	// verify the hash in the timestamp response matches the artifact hash
+	switch {
+	case !ts.HashAlgorithm.Available():
+		// an unknown algorithm is parsed as crypto.Hash(0), which cannot hash
+		err = fmt.Errorf("unsupported message imprint hash algorithm %v", ts.HashAlgorithm)
+	case imprint.Artifact != nil:
+		err = verifyHashedMessages(ts.HashAlgorithm.New(), ts.HashedMessage, imprint.Artifact)
+	default:
+		err = verifyDigest(ts, imprint.Hash, imprint.Digest)
+	}
+	checks = append(checks, Check{Name: CheckMessageImprint, Err: err})
+
+	return ts, checks, nil
+}
+
+func firstFailure(checks []Check) error {
+	for _, c := range checks {
+		if c.Err != nil {
+			return c.Err
+		}
 	}
+	return nil
+}
 
-	if err = verifyLeafCert(*ts, opts); err != nil {
-		return nil, err
+// VerifyIssuedResponse verifies a freshly issued timestamp response against
+// the request and the public key of the leaf certificate of the issuer. The
+// certificate chain is not verified, as issuers check it before signing.
+func VerifyIssuedResponse(tsrBytes []byte, req *timestamp.Request, leaf *x509.Certificate) error {
+	ts, err := timestamp.ParseResponse(tsrBytes)
+	if err != nil {
+		return fmt.Errorf("error parsing response into Timestamp: %w", err)
 	}
 
-	// verify the hash in the timestamp response matches the artifact hash
-	if err = verifyHashedMessages(ts.HashAlgorithm.New(), ts.HashedMessage, artifact); err != nil {
-		return nil, err
+	p7Message, err := pkcs7.Parse(ts.RawToken)
+	if err != nil {
+		return fmt.Errorf("error parsing hashed message: %w", err)
+	}
+	// only the leaf may have signed the response, whichever certificates it embeds
+	p7Message.Certificates = []*x509.Certificate{leaf}
+	if err := p7Message.Verify(); err != nil {
+		return fmt.Errorf("error verifying signature: %w", err)
+	}
+	if len(ts.Certificates) != 0 {
+		if err := verifyEmbeddedLeafCert(ts.Certificates[0], VerifyOpts{TSACertificate: leaf}); err != nil {
+			return err
+		}
 	}
 
-	// if the parsed timestamp is verified, return the timestamp
-	return ts, nil
+	if ts.HashAlgorithm != req.HashAlgorithm || !bytes.Equal(ts.HashedMessage, req.HashedMessage) {
+		return fmt.Errorf("message imprint does not match the request")
+	}
+	return verifyNonce(ts.Nonce, VerifyOpts{Nonce: req.Nonce})
 }
 
 func verifyTSRWithChain(ts *timestamp.Timestamp, opts VerifyOpts) error {
@@ -287,6 +397,17 @@ func verifyTSRWithChain(ts *timestamp.Timestamp, opts VerifyOpts) error {
 	return nil
 }
 
+// Verify that the TSR's hashed message matches a digest of the timestamped artifact
+func verifyDigest(ts *timestamp.Timestamp, hashAlg crypto.Hash, digest []byte) error {
+	if ts.HashAlgorithm != hashAlg {
+		return fmt.Errorf("TSR hash algorithm %s does not match the digest hash algorithm %s", ts.HashAlgorithm, hashAlg)
+	}
+	if !bytes.Equal(digest, ts.HashedMessage) {
+		return fmt.Errorf("hashed messages don't match")
+	}
+	return nil
+}
+
 // Verify that the TSR's hashed message matches the digest of the artifact to be timestamped
 func verifyHashedMessages(hashAlg hash.Hash, hashedMessage []byte, artifactReader io.Reader) error {
 	h := hashAlg
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"github.com/sigstore/timestamp-authority/pkg/client/mock"
 	tsatimestamp "github.com/sigstore/timestamp-authority/pkg/generated/client/timestamp"
 	"github.com/sigstore/timestamp-authority/pkg/signer"
+	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
 )
 
 func TestVerifyArtifactHashedMessages(t *testing.T) {
@@ -339,6 +341,38 @@ func TestVerifySubjectCommonName(t *testing.T) {
 	}
 }
 
+func TestVerifyCertificatePolicy(t *testing.T) {
+	authorized := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2}
+	cert := &x509.Certificate{PolicyIdentifiers: []asn1.ObjectIdentifier{authorized}}
+
+	type test struct {
+		policy              asn1.ObjectIdentifier
+		require             bool
+		expectVerifySuccess bool
+	}
+
+	tests := []test{
+		{policy: authorized, require: true, expectVerifySuccess: true},
+		{policy: asn1.ObjectIdentifier{1, 2, 3}, require: true, expectVerifySuccess: false},
+		{policy: asn1.ObjectIdentifier{1, 2, 3}, require: false, expectVerifySuccess: true},
+	}
+	for _, tc := range tests {
+		err := verifyCertificatePolicy(cert, tc.policy, VerifyOpts{RequireCertificatePolicy: tc.require})
+		if err != nil && tc.expectVerifySuccess {
+			t.Errorf("expected verification of policy %v to pass, got %v", tc.policy, err)
+		}
+		if err == nil && !tc.expectVerifySuccess {
+			t.Errorf("expected verification of policy %v to fail", tc.policy)
+		}
+	}
+
+	// anyPolicy authorizes no policy in particular
+	anyPolicyCert := &x509.Certificate{PolicyIdentifiers: []asn1.ObjectIdentifier{{2, 5, 29, 32, 0}}}
+	if err := verifyCertificatePolicy(anyPolicyCert, authorized, VerifyOpts{RequireCertificatePolicy: true}); err == nil {
+		t.Error("expected verification of a certificate listing anyPolicy to fail")
+	}
+}
+
 func TestVerifyESSCertID(t *testing.T) {
 	type test struct {
 		optsIssuer           pkix.Name
@@ -627,3 +661,88 @@ func TestVerifyTSRWithChain(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTimestampResponse(t *testing.T) {
+	c, err := mock.NewTSAClient(mock.TSAClientOptions{Time: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	artifact := []byte("artifact")
+	tsq, err := timestamp.CreateRequest(bytes.NewReader(artifact), &timestamp.RequestOptions{Hash: crypto.SHA256, Certificates: true})
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+	params := tsatimestamp.NewGetTimestampResponseParams()
+	params.Request = io.NopCloser(bytes.NewReader(tsq))
+	var tsr bytes.Buffer
+	if _, err := c.Timestamp.GetTimestampResponse(params, &tsr); err != nil {
+		t.Fatalf("unexpected error getting timestamp response: %v", err)
+	}
+	chain, err := c.Timestamp.GetTimestampCertChain(nil)
+	if err != nil {
+		t.Fatalf("unexpected error getting timestamp chain: %v", err)
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(chain.Payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := VerifyOpts{TSACertificate: certs[0], Intermediates: certs[1:2], Roots: certs[2:], CommonName: "wrong"}
+	digest := crypto.SHA256.New()
+	digest.Write(artifact)
+
+	for _, tc := range []struct {
+		name    string
+		imprint Imprint
+		failed  []string
+	}{
+		{"artifact", Imprint{Artifact: bytes.NewReader(artifact)}, []string{CheckCommonName}},
+		{"digest", Imprint{Hash: crypto.SHA256, Digest: digest.Sum(nil)}, []string{CheckCommonName}},
+		{"wrong digest", Imprint{Hash: crypto.SHA256, Digest: make([]byte, 32)}, []string{CheckCommonName, CheckMessageImprint}},
+		{"wrong algorithm", Imprint{Hash: crypto.SHA384, Digest: make([]byte, 48)}, []string{CheckCommonName, CheckMessageImprint}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, checks, err := CheckTimestampResponse(tsr.Bytes(), tc.imprint, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// every check runs, even after one failed
+			var names, failed []string
+			for _, c := range checks {
+				names = append(names, c.Name)
+				if c.Err != nil {
+					failed = append(failed, c.Name)
+				}
+			}
+			if want := []string{CheckSignature, CheckTSACertificate, CheckCriticalEKU, CheckESSCertID, CheckCommonName, CheckTimestampingEKU, CheckMessageImprint}; !reflect.DeepEqual(names, want) {
+				t.Fatalf("expected checks %v, got %v", want, names)
+			}
+			if !reflect.DeepEqual(failed, tc.failed) {
+				t.Fatalf("expected failed checks %v, got %v", tc.failed, failed)
+			}
+		})
+	}
+
+	if _, _, err := CheckTimestampResponse([]byte("garbage"), Imprint{Artifact: bytes.NewReader(artifact)}, opts); err == nil {
+		t.Fatal("expected an error parsing an invalid response")
+	}
+
+	// a response without certificates is parsed without verifying its
+	// signature, so its message imprint algorithm may be anything
+	tsa := c.Timestamp.(*mock.TSAClient)
+	certless, err := (&timestamp.Timestamp{
+		HashAlgorithm: crypto.SHA256,
+		HashedMessage: digest.Sum(nil),
+		Time:          time.Now(),
+		Policy:        asn1.ObjectIdentifier{1, 2, 3},
+	}).CreateResponseWithOpts(tsa.CertChain[0], tsa.Signer, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownHash, err := testutils.WithUnknownImprintAlgorithm(certless)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := CheckTimestampResponse(unknownHash, Imprint{Artifact: bytes.NewReader(artifact)}, opts); err == nil {
+		t.Fatal("expected an error for an unknown message imprint algorithm")
+	}
+}
@@ -116,6 +116,12 @@ func GenerateSubordinateCa(rootTemplate *x509.Certificate, rootPriv crypto.Signe
 }
 
 func GenerateLeafCert(parentTemplate *x509.Certificate, parentPriv crypto.Signer) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	return GenerateLeafCertWithPolicies(parentTemplate, parentPriv)
+}
+
+// GenerateLeafCertWithPolicies generates a timestamping leaf certificate
+// listing the policies in its certificatePolicies extension.
+func GenerateLeafCertWithPolicies(parentTemplate *x509.Certificate, parentPriv crypto.Signer, policies ...asn1.ObjectIdentifier) (*x509.Certificate, *ecdsa.PrivateKey, error) {
 	timestampExt, err := asn1.Marshal([]asn1.ObjectIdentifier{{1, 3, 6, 1, 5, 5, 7, 3, 8}})
 	if err != nil {
 		return nil, nil, err
@@ -127,10 +133,11 @@ func GenerateLeafCert(parentTemplate *x509.Certificate, parentPriv crypto.Signer
 			CommonName:   "Test TSA Timestamping Leaf",
 			Organization: []string{"local"},
 		},
-		NotBefore: time.Now().Add(-1 * time.Minute),
-		NotAfter:  time.Now().Add(time.Hour),
-		KeyUsage:  x509.KeyUsageDigitalSignature,
-		IsCA:      false,
+		NotBefore:         time.Now().Add(-1 * time.Minute),
+		NotAfter:          time.Now().Add(time.Hour),
+		KeyUsage:          x509.KeyUsageDigitalSignature,
+		IsCA:              false,
+		PolicyIdentifiers: policies,
 		// set EKU to x509.ExtKeyUsageTimeStamping but with a critical bit
 		ExtraExtensions: []pkix.Extension{
 			{
@@ -0,0 +1,48 @@
+// Copyright 2026 The Sigstore Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+)
+
+// WithUnknownImprintAlgorithm replaces the SHA-256 OID of the message imprint
+// of a response with an unassigned OID, updating the digest of the content
+// in the signed attributes, which is checked even when the signature is not.
+func WithUnknownImprintAlgorithm(tsr []byte) ([]byte, error) {
+	ts, err := timestamp.ParseResponse(tsr)
+	if err != nil {
+		return nil, err
+	}
+	p7, err := pkcs7.Parse(ts.RawToken)
+	if err != nil {
+		return nil, err
+	}
+	sha256OID := []byte{0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01}
+	i := bytes.Index(p7.Content, sha256OID)
+	if i < 0 {
+		return nil, errors.New("no SHA-256 message imprint in the response")
+	}
+	content := bytes.Clone(p7.Content)
+	content[i+len(sha256OID)-1] = 0x7f
+	oldDigest, newDigest := sha256.Sum256(p7.Content), sha256.Sum256(content)
+	out := bytes.Replace(tsr, p7.Content, content, 1)
+	return bytes.Replace(out, oldDigest[:], newDigest[:], 1), nil
+}
@@ -19,6 +19,10 @@ import (
 	"crypto/x509"
 	"encoding/asn1"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
 )
@@ -27,6 +31,8 @@ var (
 	// EKUOID is the Extended Key Usage OID, per RFC 5280
 	EKUOID             = asn1.ObjectIdentifier{2, 5, 29, 37}
 	EKUTimestampingOID = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 8}
+	// AnyPolicyOID is the anyPolicy certificate policy, per RFC 5280
+	AnyPolicyOID = asn1.ObjectIdentifier{2, 5, 29, 32, 0}
 )
 
 // VerifyCertChain verifies that the certificate chain is valid for issuing
@@ -110,3 +116,60 @@ func VerifyCertChain(certs []*x509.Certificate, signer crypto.Signer) error {
 	// Verify the key's strength
 	return cryptoutils.ValidatePubKey(signer.Public())
 }
+
+// VerifyCertChainValidity verifies that every certificate in the chain is
+// within its validity window at the provided time, which should be the
+// generation time of a timestamp issued with the chain.
+func VerifyCertChainValidity(certs []*x509.Certificate, t time.Time) error {
+	for _, c := range certs {
+		if t.Before(c.NotBefore) {
+			return fmt.Errorf("certificate %q is not valid until %s", c.Subject.String(), c.NotBefore.UTC().Format(time.RFC3339))
+		}
+		if t.After(c.NotAfter) {
+			return fmt.Errorf("certificate %q expired at %s", c.Subject.String(), c.NotAfter.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// VerifyCertificatePolicy verifies that the policy is listed in the
+// certificatePolicies extension of the certificate, which authorizes the
+// certificate to issue timestamps under the policy. anyPolicy does not match:
+// it authorizes no policy in particular, so a certificate only listing it
+// does not bind the timestamp authority to the policy of its timestamps.
+func VerifyCertificatePolicy(cert *x509.Certificate, policy asn1.ObjectIdentifier) error {
+	for _, p := range cert.PolicyIdentifiers {
+		if p.Equal(policy) {
+			return nil
+		}
+	}
+	return fmt.Errorf("policy %s is not listed in the certificate policies of %q", policy, cert.Subject.String())
+}
+
+// ParseOID parses a dotted-decimal object identifier such as 1.2.3.4.
+func ParseOID(s string) (asn1.ObjectIdentifier, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid OID %q", s)
+	}
+	oid := make(asn1.ObjectIdentifier, 0, len(parts))
+	for _, p := range parts {
+		i, err := strconv.Atoi(p)
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("invalid OID %q", s)
+		}
+		oid = append(oid, i)
+	}
+	return oid, nil
+}
+
+// EarliestExpiry returns the certificate in the chain that expires first.
+func EarliestExpiry(certs []*x509.Certificate) *x509.Certificate {
+	var earliest *x509.Certificate
+	for _, c := range certs {
+		if earliest == nil || c.NotAfter.Before(earliest.NotAfter) {
+			earliest = c
+		}
+	}
+	return earliest
+}
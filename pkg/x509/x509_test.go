@@ -16,8 +16,10 @@ package x509
 
 import (
 	"crypto/x509"
+	"encoding/asn1"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/sigstore/timestamp-authority/pkg/x509/testutils"
 )
@@ -47,3 +49,59 @@ func TestVerifyCertChain(t *testing.T) {
 		t.Fatalf("expected failure verifying certificate chain: %v", err)
 	}
 }
+
+func TestVerifyCertChainValidity(t *testing.T) {
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, _, _ := testutils.GenerateLeafCert(subCert, subKey)
+	chain := []*x509.Certificate{leafCert, subCert, rootCert}
+
+	if err := VerifyCertChainValidity(chain, time.Now()); err != nil {
+		t.Fatalf("unexpected failure verifying certificate validity: %v", err)
+	}
+
+	// failure: before the leaf is valid
+	if err := VerifyCertChainValidity(chain, leafCert.NotBefore.Add(-time.Second)); err == nil || !strings.Contains(err.Error(), "is not valid until") {
+		t.Fatalf("expected failure verifying certificate validity: %v", err)
+	}
+
+	// failure: after the earliest certificate expires
+	earliest := EarliestExpiry(chain)
+	if err := VerifyCertChainValidity(chain, earliest.NotAfter.Add(time.Second)); err == nil || !strings.Contains(err.Error(), "expired at") {
+		t.Fatalf("expected failure verifying certificate validity: %v", err)
+	}
+}
+
+func TestVerifyCertificatePolicy(t *testing.T) {
+	authorized := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2}
+	rootCert, rootKey, _ := testutils.GenerateRootCa()
+	subCert, subKey, _ := testutils.GenerateSubordinateCa(rootCert, rootKey)
+	leafCert, leafKey, _ := testutils.GenerateLeafCertWithPolicies(subCert, subKey, authorized)
+	if err := VerifyCertChain([]*x509.Certificate{leafCert, subCert, rootCert}, leafKey); err != nil {
+		t.Fatalf("unexpected failure verifying certificate chain: %v", err)
+	}
+
+	if err := VerifyCertificatePolicy(leafCert, authorized); err != nil {
+		t.Fatalf("unexpected failure verifying listed policy: %v", err)
+	}
+	if err := VerifyCertificatePolicy(leafCert, asn1.ObjectIdentifier{1, 2, 3}); err == nil || !strings.Contains(err.Error(), "is not listed") {
+		t.Fatalf("expected failure verifying unlisted policy: %v", err)
+	}
+
+	anyPolicyCert, _, _ := testutils.GenerateLeafCertWithPolicies(subCert, subKey, AnyPolicyOID)
+	if err := VerifyCertificatePolicy(anyPolicyCert, authorized); err == nil {
+		t.Fatal("expected failure verifying a policy against anyPolicy")
+	}
+}
+
+func TestParseOID(t *testing.T) {
+	oid, err := ParseOID("1.3.6.1.4.1.57264.2")
+	if err != nil || !oid.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 2}) {
+		t.Fatalf("unexpected result parsing OID: %v %v", oid, err)
+	}
+	for _, s := range []string{"", "1", "1.a", "1.-2"} {
+		if _, err := ParseOID(s); err == nil {
+			t.Fatalf("expected error parsing %q", s)
+		}
+	}
+}